@@ -5,89 +5,102 @@ package numeric
 #include <mpfr.h>
 */
 import "C"
+import "fmt"
 
 // region Public
 
 // Add a number and return the result. This will not modify the original number.
 func (n Numeric) Add(x any) Numeric {
+	return n.AddRounded(x, RoundNearest)
+}
+
+// Add a number and return the result, rounding the result with the given rounding mode. This will not modify the original number.
+func (n Numeric) AddRounded(x any, mode RoundingMode) Numeric {
 	if !n.init {
 		n = New(0)
 	}
 
-	result := New(0)
-
 	switch x := x.(type) {
 	case Numeric:
 		if !x.init {
 			return n
 		}
 
-		C.mpfr_add(&result.val[0], &n.val[0], &x.val[0], C.MPFR_RNDN)
+		return n.add(x, maxPrecision(n, x), mode)
 
 	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
-		_x := New(x)
-		C.mpfr_add(&result.val[0], &n.val[0], &_x.val[0], C.MPFR_RNDN)
+		_x := NewWithPrecision(x, n.Precision())
+		return n.add(_x, maxPrecision(n, _x), mode)
 	}
 
-	return result
+	return New(0)
 }
 
 // Subtract a number and return the result. This will not modify the original number.
 func (n Numeric) Subtract(x any) Numeric {
+	return n.SubtractRounded(x, RoundNearest)
+}
+
+// Subtract a number and return the result, rounding the result with the given rounding mode. This will not modify the original number.
+func (n Numeric) SubtractRounded(x any, mode RoundingMode) Numeric {
 	if !n.init {
 		n = New(0)
 	}
 
-	result := New(0)
-
 	switch x := x.(type) {
 	case Numeric:
 		if !x.init {
 			return n
 		}
 
-		C.mpfr_sub(&result.val[0], &n.val[0], &x.val[0], C.MPFR_RNDN)
+		return n.subtract(x, maxPrecision(n, x), mode)
 
 	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
-		_x := New(x)
-		C.mpfr_sub(&result.val[0], &n.val[0], &_x.val[0], C.MPFR_RNDN)
+		_x := NewWithPrecision(x, n.Precision())
+		return n.subtract(_x, maxPrecision(n, _x), mode)
 	}
 
-	return result
+	return New(0)
 }
 
 // Multiply a number and return the result. This will not modify the original number.
 func (n Numeric) Multiply(x any) Numeric {
+	return n.MultiplyRounded(x, RoundNearest)
+}
+
+// Multiply a number and return the result, rounding the result with the given rounding mode. This will not modify the original number.
+func (n Numeric) MultiplyRounded(x any, mode RoundingMode) Numeric {
 	if !n.init {
 		n = New(0)
 	}
 
-	result := New(0)
-
 	switch x := x.(type) {
 	case Numeric:
 		if !x.init {
 			return n
 		}
 
-		C.mpfr_mul(&result.val[0], &n.val[0], &x.val[0], C.MPFR_RNDN)
+		return n.multiply(x, maxPrecision(n, x), mode)
 
 	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
-		_x := New(x)
-		C.mpfr_mul(&result.val[0], &n.val[0], &_x.val[0], C.MPFR_RNDN)
+		_x := NewWithPrecision(x, n.Precision())
+		return n.multiply(_x, maxPrecision(n, _x), mode)
 	}
 
-	return result
+	return New(0)
 }
 
 // Divide a number and return the result. This will not modify the original number.
 func (n Numeric) Divide(x any) Numeric {
+	return n.DivideRounded(x, RoundNearest)
+}
+
+// Divide a number and return the result, rounding the result with the given rounding mode. This will not modify the original number.
+func (n Numeric) DivideRounded(x any, mode RoundingMode) Numeric {
 	if !n.init {
 		n = New(0)
 	}
 
-	result := New(0)
-
 	switch x := x.(type) {
 	case Numeric:
 		if !x.init {
@@ -95,57 +108,135 @@ func (n Numeric) Divide(x any) Numeric {
 		}
 
 		if x.Equal(0) {
-			panic("numeric: Division by zero")
+			panic(ErrDivisionByZero.Error())
 		}
 
-		C.mpfr_div(&result.val[0], &n.val[0], &x.val[0], C.MPFR_RNDN)
+		return n.divide(x, maxPrecision(n, x), mode)
 
 	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
-		_x := New(x)
+		_x := NewWithPrecision(x, n.Precision())
 
 		if _x.Equal(0) {
-			panic("numeric: Division by zero")
+			panic(ErrDivisionByZero.Error())
 		}
 
-		C.mpfr_div(&result.val[0], &n.val[0], &_x.val[0], C.MPFR_RNDN)
+		return n.divide(_x, maxPrecision(n, _x), mode)
 	}
 
-	return result
+	return New(0)
 }
 
 // Exponent the current number to the power of `x` and return the result. This will not modify the original number.
+// The exponent can be any real number, e.g. 1.05^(1/12) or 2^-3. Negative numbers can only be raised to integer exponents,
+// as other exponents give complex results. Panics if the result is not a real number, or if zero is raised to a negative exponent.
 func (n Numeric) Pow(power any) Numeric {
+	return n.PowRounded(power, RoundNearest)
+}
+
+// Exponent the current number to the power of `x` and return the result, rounding the result with the given rounding mode.
+// See Pow for the supported exponents. This will not modify the original number.
+func (n Numeric) PowRounded(power any, mode RoundingMode) Numeric {
 	if !n.init {
 		n = New(0)
 	}
 
-	result := New(0)
-
+	var _x Numeric
 	switch x := power.(type) {
 	case Numeric:
 		if !x.init {
 			return n
 		}
 
-		if x.LessThan(0) {
-			panic("numeric: Exponent has to be greater than or equal to zero")
-		}
-
-		C.mpfr_pow_ui(&result.val[0], &n.val[0], C.ulong(x.Uint()), C.MPFR_RNDN)
+		_x = x
 
 	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
-		_x := New(x)
+		_x = NewWithPrecision(x, n.Precision())
 
-		if _x.LessThan(0) {
-			panic("numeric: Exponent has to be greater than or equal to zero")
-		}
+	default:
+		return New(0)
+	}
 
-		C.mpfr_pow_ui(&result.val[0], &n.val[0], C.ulong(_x.Uint()), C.MPFR_RNDN)
+	result, err := n.checkedPow(_x, maxPrecision(n, _x), mode)
+	if err != nil {
+		panic(err.Error())
 	}
 
 	return result
 }
 
+// Add a number and return the result, with an error if `x` has an unsupported type. This will not modify the original number.
+func (n Numeric) AddE(x any) (Numeric, error) {
+	if !n.init {
+		n = New(0)
+	}
+
+	_x, err := n.operand(x)
+	if err != nil {
+		return Numeric{}, err
+	}
+
+	return n.add(_x, maxPrecision(n, _x), RoundNearest), nil
+}
+
+// Subtract a number and return the result, with an error if `x` has an unsupported type. This will not modify the original number.
+func (n Numeric) SubtractE(x any) (Numeric, error) {
+	if !n.init {
+		n = New(0)
+	}
+
+	_x, err := n.operand(x)
+	if err != nil {
+		return Numeric{}, err
+	}
+
+	return n.subtract(_x, maxPrecision(n, _x), RoundNearest), nil
+}
+
+// Multiply a number and return the result, with an error if `x` has an unsupported type. This will not modify the original number.
+func (n Numeric) MultiplyE(x any) (Numeric, error) {
+	if !n.init {
+		n = New(0)
+	}
+
+	_x, err := n.operand(x)
+	if err != nil {
+		return Numeric{}, err
+	}
+
+	return n.multiply(_x, maxPrecision(n, _x), RoundNearest), nil
+}
+
+// Divide a number and return the result, with an error if `x` has an unsupported type or is zero.
+// This will not modify the original number.
+func (n Numeric) DivideE(x any) (Numeric, error) {
+	if !n.init {
+		n = New(0)
+	}
+
+	_x, err := n.operand(x)
+	if err != nil {
+		return Numeric{}, err
+	}
+
+	return n.checkedDivide(_x, maxPrecision(n, _x), RoundNearest)
+}
+
+// Exponent the current number to the power of `x` and return the result, with an error if `x` has an unsupported type,
+// if the result is not a real number or if zero is raised to a negative exponent. See Pow for the supported exponents.
+// This will not modify the original number.
+func (n Numeric) PowE(power any) (Numeric, error) {
+	if !n.init {
+		n = New(0)
+	}
+
+	_x, err := n.operand(power)
+	if err != nil {
+		return Numeric{}, err
+	}
+
+	return n.checkedPow(_x, maxPrecision(n, _x), RoundNearest)
+}
+
 // Makes the number negative. This will modify the original number.
 func (n Numeric) Neg() Numeric {
 	if !n.init {
@@ -167,3 +258,97 @@ func (n Numeric) Abs() Numeric {
 }
 
 // endregion
+
+// region Private
+
+// Converts the operand of a checked operation. Numeric values are used as they are, with uninitialized values being zero.
+// Other supported types are created with the precision of the number.
+func (n Numeric) operand(x any) (Numeric, error) {
+	switch x := x.(type) {
+	case Numeric:
+		if !x.init {
+			return NewWithPrecision(0, n.Precision()), nil
+		}
+
+		return x, nil
+
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return newWithPrecisionWithError(x, n.Precision(), RoundNearest)
+	}
+
+	return Numeric{}, fmt.Errorf("%w. Type has to be int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64 or Numeric. Got: %T", ErrInvalidType, x)
+}
+
+// Divides the number by `x`, with an error if `x` is zero.
+func (n Numeric) checkedDivide(x Numeric, bits uint64, rnd RoundingMode) (Numeric, error) {
+	if x.Equal(0) {
+		return Numeric{}, ErrDivisionByZero
+	}
+
+	return n.divide(x, bits, rnd), nil
+}
+
+// Raises the number to the power of `x`, with an error if the result is not a real number, or if it is too large to be represented.
+func (n Numeric) checkedPow(x Numeric, bits uint64, rnd RoundingMode) (Numeric, error) {
+	if n.Equal(0) && x.LessThan(0) {
+		return Numeric{}, ErrDivisionByZero
+	}
+
+	result := n.pow(x, bits, rnd)
+
+	if C.mpfr_nan_p(&result.val[0]) != 0 {
+		return Numeric{}, fmt.Errorf("%w. %s raised to the power of %s is complex", ErrNotReal, n.errorString(), x.errorString())
+	}
+
+	if C.mpfr_inf_p(&result.val[0]) != 0 {
+		return Numeric{}, fmt.Errorf("%w. %s raised to the power of %s is too large", ErrOutOfRange, n.errorString(), x.errorString())
+	}
+
+	return result, nil
+}
+
+// Returns the number for error messages, in scientific notation so that numbers with huge exponents give short messages.
+func (n Numeric) errorString() string {
+	return n.StringScientific(10)
+}
+
+// The operations below expect initialized operands, and round the result to `bits` precision bits using `rnd`.
+
+func (n Numeric) add(x Numeric, bits uint64, rnd RoundingMode) Numeric {
+	result := newEmpty(bits)
+	C.mpfr_add(&result.val[0], &n.val[0], &x.val[0], rnd.mpfr())
+	return result
+}
+
+func (n Numeric) subtract(x Numeric, bits uint64, rnd RoundingMode) Numeric {
+	result := newEmpty(bits)
+	C.mpfr_sub(&result.val[0], &n.val[0], &x.val[0], rnd.mpfr())
+	return result
+}
+
+func (n Numeric) multiply(x Numeric, bits uint64, rnd RoundingMode) Numeric {
+	result := newEmpty(bits)
+	C.mpfr_mul(&result.val[0], &n.val[0], &x.val[0], rnd.mpfr())
+	return result
+}
+
+func (n Numeric) divide(x Numeric, bits uint64, rnd RoundingMode) Numeric {
+	result := newEmpty(bits)
+	C.mpfr_div(&result.val[0], &n.val[0], &x.val[0], rnd.mpfr())
+	return result
+}
+
+func (n Numeric) pow(x Numeric, bits uint64, rnd RoundingMode) Numeric {
+	result := newEmpty(bits)
+
+	// mpfr_pow also gives real results for negative bases with integer exponents, whatever their size
+	if C.mpfr_integer_p(&x.val[0]) != 0 && C.mpfr_fits_slong_p(&x.val[0], C.MPFR_RNDN) != 0 {
+		C.mpfr_pow_si(&result.val[0], &n.val[0], C.mpfr_get_si(&x.val[0], C.MPFR_RNDN), rnd.mpfr())
+	} else {
+		C.mpfr_pow(&result.val[0], &n.val[0], &x.val[0], rnd.mpfr())
+	}
+
+	return result
+}
+
+// endregion
@@ -0,0 +1,23 @@
+package numeric
+
+import "testing"
+
+func TestPowPrecision(t *testing.T) {
+	x := NewWithPrecision("1.5", 200)
+
+	if got := New(2).Pow(x).Precision(); got != 200 {
+		t.Errorf("New(2).Pow(x).Precision() = %d, want 200", got)
+	}
+
+	if got := New(2).PowRounded(x, RoundUp).Precision(); got != 200 {
+		t.Errorf("New(2).PowRounded(x, RoundUp).Precision() = %d, want 200", got)
+	}
+
+	if got, err := New(2).PowE(x); err != nil || got.Precision() != 200 {
+		t.Errorf("New(2).PowE(x) = %d bits, %v, want 200 bits", got.Precision(), err)
+	}
+
+	if got := NewWithPrecision(2, 200).Pow(3).Precision(); got != 200 {
+		t.Errorf("NewWithPrecision(2, 200).Pow(3).Precision() = %d, want 200", got)
+	}
+}
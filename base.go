@@ -0,0 +1,151 @@
+package numeric
+
+/*
+#cgo LDFLAGS: -lmpfr
+#include <mpfr.h>
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"errors"
+	"math/bits"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// region Public
+
+// Returns numeric as a string in a base from 2 to 62 with a specified number of significant digits.
+// With 0 digits, as many digits as needed to convert back to the same number are used.
+// Digits above 9 are written as lowercase letters for bases up to 36, and as uppercase then lowercase letters for larger bases.
+// Numbers are written in fixed notation, e.g. 5 in base 2 is 101 and 0.75 is 0.11, unless that needs too many zeros.
+// Then an exponent is appended after '@', which is a power of the base written in decimal, e.g. 2^-10 in base 2 is 1@-10.
+// Trailing zeros after the point are omitted. The result can be parsed with NewFromBase.
+// Infinity is inf, like in StringHexFloat.
+func (n Numeric) StringBase(base int, digits int) string {
+	if !n.init {
+		n = New(0)
+	}
+
+	if base < 2 || base > 62 {
+		panic("numeric: Invalid base. Base has to be between 2 and 62")
+	}
+
+	if digits < 0 {
+		panic("numeric: Significant digits cannot be negative")
+	}
+
+	if C.mpfr_zero_p(&n.val[0]) != 0 {
+		return "0"
+	}
+
+	if str, ok := n.nonFinite(); ok {
+		return str
+	}
+
+	var exp C.mpfr_exp_t
+
+	out := C.mpfr_get_str(nil, &exp, C.int(base), C.size_t(digits), &n.val[0], C.MPFR_RNDN)
+	defer C.mpfr_free_str(out)
+
+	str := C.GoString(out)
+
+	sign := ""
+	if strings.HasPrefix(str, "-") {
+		sign, str = "-", str[1:]
+	}
+
+	// The number is 0.str * base^exp, so exp is the number of digits before the point
+	str = strings.TrimRight(str, "0")
+	integerDigits := int(exp)
+
+	if integerDigits > -6 && integerDigits <= len(str)+6 {
+		return sign + pointAfter(str, integerDigits)
+	}
+
+	return sign + pointAfter(str, 1) + "@" + strconv.Itoa(integerDigits-1)
+}
+
+// Returns numeric as a hex-float string, such as 0x1.8p+0 for 1.5, with all the significant bits of the number.
+// The conversion is exact, so the result converts back to the same number with NewFromBase(s, 16).
+func (n Numeric) StringHexFloat() string {
+	if !n.init {
+		n = New(0)
+	}
+
+	return n.sprintf("", 'a', 0, -1)
+}
+
+// Creates a new numeric value from a string in a base from 2 to 62, with error handling.
+// The string has an optional sign, digits with an optional point and an optional exponent after '@', which is a power of the base
+// written in decimal, e.g. "101.1" or "1.011@2" in base 2. Bases up to 10 also accept an exponent after 'e' or 'E'.
+// Bases 2 and 16 also accept a "0b" or "0x" prefix and a binary exponent after 'p' or 'P', such as the hex-float "0x1.8p+0".
+// The precision is PrecisionBits, raised to fit all the digits in bases that are a power of two, so hex-float strings convert exactly.
+func NewFromBase(s string, base int) (Numeric, error) {
+	if base < 2 || base > 62 {
+		return Numeric{}, errors.New("numeric: Invalid base. Base has to be between 2 and 62")
+	}
+
+	str := strings.TrimSpace(s)
+	if str == "" {
+		return Numeric{}, errors.New("numeric: Invalid string. String cannot be empty")
+	}
+
+	num, err := newEmptyWithError(max(PrecisionBits, baseDigitBits(str, base)))
+	if err != nil {
+		return Numeric{}, err
+	}
+
+	cstr := C.CString(str)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var end *C.char
+	C.mpfr_strtofr(&num.val[0], cstr, &end, C.int(base), C.MPFR_RNDN)
+
+	// The whole string has to be a number, infinities and NaN are not numbers
+	consumed := uintptr(unsafe.Pointer(end)) - uintptr(unsafe.Pointer(cstr))
+	if int(consumed) != len(str) || C.mpfr_number_p(&num.val[0]) == 0 {
+		return Numeric{}, errors.New("numeric: Invalid string. String has to be a number in base " + strconv.Itoa(base))
+	}
+
+	return num, nil
+}
+
+// endregion
+
+// region Private
+
+// Returns the number of bits needed to hold all the digits of a string in a base that is a power of two, or 0 for other bases.
+func baseDigitBits(str string, base int) uint64 {
+	if base&(base-1) != 0 {
+		return 0
+	}
+
+	str = strings.TrimLeft(str, "+-")
+	switch base {
+	case 2:
+		str = strings.TrimPrefix(strings.TrimPrefix(str, "0b"), "0B")
+	case 16:
+		str = strings.TrimPrefix(strings.TrimPrefix(str, "0x"), "0X")
+	}
+
+	// Only the digits before the exponent are significant
+	markers := "@"
+	if base == 2 || base == 16 {
+		markers += "pP"
+	}
+	if base <= 10 {
+		markers += "eE"
+	}
+
+	if i := strings.IndexAny(str, markers); i >= 0 {
+		str = str[:i]
+	}
+
+	digits := len(strings.Trim(strings.ReplaceAll(str, ".", ""), "0"))
+
+	return uint64(digits * bits.TrailingZeros(uint(base)))
+}
+
+// endregion
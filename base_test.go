@@ -0,0 +1,47 @@
+package numeric
+
+import "testing"
+
+func TestStringBase(t *testing.T) {
+	tests := []struct {
+		value  string
+		base   int
+		digits int
+		want   string
+	}{
+		{"0", 2, 0, "0"},
+		{"5", 2, 0, "101"},
+		{"0.75", 2, 0, "0.11"},
+		{"255", 16, 0, "ff"},
+		{"-255.5", 16, 0, "-ff.8"},
+		{"0.0009765625", 2, 0, "1@-10"},
+		{"1e10", 10, 3, "1@10"},
+		{"35", 36, 0, "z"},
+		{"61", 62, 0, "z"},
+	}
+
+	for _, tt := range tests {
+		got := New(tt.value).StringBase(tt.base, tt.digits)
+		if got != tt.want {
+			t.Errorf("New(%q).StringBase(%d, %d) = %s, want %s", tt.value, tt.base, tt.digits, got, tt.want)
+			continue
+		}
+
+		if n, err := NewFromBase(got, tt.base); err != nil || !n.Equal(New(tt.value)) {
+			t.Errorf("NewFromBase(%q, %d) = %s, %v, want %s", got, tt.base, n.ShortestString(), err, tt.value)
+		}
+	}
+}
+
+func TestStringBaseInfinity(t *testing.T) {
+	big := New("1e200000000")
+	inf := big.Multiply(big)
+
+	if got := inf.StringBase(16, 0); got != "inf" {
+		t.Errorf("inf.StringBase(16, 0) = %s, want inf", got)
+	}
+
+	if got := inf.Neg().StringBase(2, 5); got != "-inf" {
+		t.Errorf("-inf.StringBase(2, 5) = %s, want -inf", got)
+	}
+}
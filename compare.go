@@ -93,4 +93,13 @@ func (n Numeric) Equal(x any) bool {
 	return false
 }
 
+// IsInt returns true if the number is an integer.
+func (n Numeric) IsInt() bool {
+	if !n.init {
+		return true
+	}
+
+	return C.mpfr_integer_p(&n.val[0]) != 0
+}
+
 // endregion
@@ -0,0 +1,193 @@
+package numeric
+
+import "sync"
+
+// Determines what a Context does when an operation fails.
+type ErrorPolicy int
+
+const (
+	// Panics when an operation fails, just like the package level functions.
+	PanicOnError ErrorPolicy = iota
+	// Records the error in the context, which can be checked with Err. The failed operation returns zero.
+	RecordError
+)
+
+// Context holds the settings used to create, compute and format numeric values,
+// so that a library can use its own settings without touching the package level defaults.
+// A context is safe for concurrent use, as long as its settings are not modified while it is in use.
+// The zero value is ready to use, with the default precision bits, round to nearest, no decimal places and PanicOnError.
+type Context struct {
+	PrecisionBits       uint64       // Precision bits of the values created and computed by the context, zero means the default precision bits
+	Rounding            RoundingMode // Rounding mode used by arithmetic operations and formatting
+	StringDecimalPlaces uint64       // Decimal places used by Format
+	ErrorPolicy         ErrorPolicy  // What to do when an operation fails
+
+	mu  sync.Mutex
+	err error
+}
+
+// region Public
+
+// Creates a new context, initialized from the package level defaults.
+func NewContext() *Context {
+	return &Context{
+		PrecisionBits:       PrecisionBits,
+		Rounding:            RoundNearest,
+		StringDecimalPlaces: StringDecimalPlaces,
+		ErrorPolicy:         PanicOnError,
+	}
+}
+
+// Creates a new numeric value with the precision of the context.
+// The type of x has to be int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, string or Numeric.
+func (c *Context) New(x any) Numeric {
+	num, err := newWithPrecisionWithError(x, c.precision(), c.Rounding)
+	if err != nil {
+		return c.fail(err)
+	}
+
+	return num
+}
+
+// Adds `a` and `b`, rounding the result to the precision and rounding mode of the context.
+func (c *Context) Add(a any, b any) Numeric {
+	_a, _b, err := c.operands(a, b)
+	if err != nil {
+		return c.fail(err)
+	}
+
+	return _a.add(_b, c.precision(), c.Rounding)
+}
+
+// Subtracts `b` from `a`, rounding the result to the precision and rounding mode of the context.
+func (c *Context) Subtract(a any, b any) Numeric {
+	_a, _b, err := c.operands(a, b)
+	if err != nil {
+		return c.fail(err)
+	}
+
+	return _a.subtract(_b, c.precision(), c.Rounding)
+}
+
+// Multiplies `a` by `b`, rounding the result to the precision and rounding mode of the context.
+func (c *Context) Multiply(a any, b any) Numeric {
+	_a, _b, err := c.operands(a, b)
+	if err != nil {
+		return c.fail(err)
+	}
+
+	return _a.multiply(_b, c.precision(), c.Rounding)
+}
+
+// Divides `a` by `b`, rounding the result to the precision and rounding mode of the context.
+func (c *Context) Divide(a any, b any) Numeric {
+	_a, _b, err := c.operands(a, b)
+	if err != nil {
+		return c.fail(err)
+	}
+
+	result, err := _a.checkedDivide(_b, c.precision(), c.Rounding)
+	if err != nil {
+		return c.fail(err)
+	}
+
+	return result
+}
+
+// Raises `a` to the power of `b`, rounding the result to the precision and rounding mode of the context.
+func (c *Context) Pow(a any, b any) Numeric {
+	_a, _b, err := c.operands(a, b)
+	if err != nil {
+		return c.fail(err)
+	}
+
+	result, err := _a.checkedPow(_b, c.precision(), c.Rounding)
+	if err != nil {
+		return c.fail(err)
+	}
+
+	return result
+}
+
+// Returns `n` as a string with the decimal places and rounding mode of the context.
+func (c *Context) Format(n Numeric) string {
+	if !n.init {
+		n = New(0)
+	}
+
+	return n.str(c.StringDecimalPlaces, c.Rounding)
+}
+
+// Returns the first error recorded by the context, or nil if no operation has failed.
+// Errors are only recorded when the error policy is RecordError.
+func (c *Context) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.err
+}
+
+// Clears the error recorded by the context.
+func (c *Context) ClearErr() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.err = nil
+}
+
+// endregion
+
+// region Private
+
+// Converts the operands of an operation. Numeric values are used as they are, other values are created with the precision of the context.
+func (c *Context) operands(a any, b any) (Numeric, Numeric, error) {
+	_a, err := c.operand(a)
+	if err != nil {
+		return Numeric{}, Numeric{}, err
+	}
+
+	_b, err := c.operand(b)
+	if err != nil {
+		return Numeric{}, Numeric{}, err
+	}
+
+	return _a, _b, nil
+}
+
+func (c *Context) operand(x any) (Numeric, error) {
+	if x, ok := x.(Numeric); ok {
+		if !x.init {
+			return newWithPrecisionWithError(0, c.precision(), c.Rounding)
+		}
+
+		return x, nil
+	}
+
+	return newWithPrecisionWithError(x, c.precision(), c.Rounding)
+}
+
+// Returns the precision bits of the context, or the default precision bits if they are not set.
+func (c *Context) precision() uint64 {
+	if c.PrecisionBits == 0 {
+		return PrecisionBits
+	}
+
+	return c.PrecisionBits
+}
+
+// Handles a failed operation according to the error policy, returning zero with the precision of the context if it does not panic.
+func (c *Context) fail(err error) Numeric {
+	if c.ErrorPolicy == PanicOnError {
+		panic(err.Error())
+	}
+
+	c.mu.Lock()
+	if c.err == nil {
+		c.err = err
+	}
+	c.mu.Unlock()
+
+	return NewWithPrecision(0, c.precision())
+}
+
+// endregion
@@ -2,14 +2,24 @@ package numeric
 
 /*
 #cgo LDFLAGS: -lmpfr
+#include <stdint.h>
 #include <mpfr.h>
 #include <stdlib.h>
 
 extern void _panic(char* msg);
 
-static char* _str(mpfr_t num, unsigned long decimal_digits) {
+// long is 32 bits on some platforms, intmax_t has at least 64 bits everywhere
+static intmax_t _get_sj(mpfr_t num, mpfr_rnd_t rnd) {
+	return mpfr_get_sj(num, rnd);
+}
+
+static uintmax_t _get_uj(mpfr_t num, mpfr_rnd_t rnd) {
+	return mpfr_get_uj(num, rnd);
+}
+
+static char* _str(mpfr_t num, unsigned long decimal_digits, mpfr_rnd_t rnd) {
 	// Determine the size needed for the buffer
-	int size_needed = mpfr_snprintf(NULL, 0, "%.*Rf", decimal_digits, num);
+	int size_needed = mpfr_snprintf(NULL, 0, "%.*R*f", (int) decimal_digits, rnd, num);
 	if (size_needed < 0) {
 		_panic("numeric: Error determining buffer size");
 	}
@@ -21,12 +31,18 @@ static char* _str(mpfr_t num, unsigned long decimal_digits) {
 	}
 
 	// Format the mpfr_t value as a string into the allocated buffer
-	mpfr_snprintf(str, size_needed + 1, "%.*Rf", decimal_digits, num);
+	mpfr_snprintf(str, size_needed + 1, "%.*R*f", (int) decimal_digits, rnd, num);
 
 	return str;
 }
 */
 import "C"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unsafe"
+)
 
 // region Public
 
@@ -38,9 +54,7 @@ func (n Numeric) String() string {
 		n = New(0)
 	}
 
-	out := C._str(&n.val[0], C.ulong(StringDecimalPlaces))
-
-	return C.GoString(out)
+	return n.str(StringDecimalPlaces, RoundNearest)
 }
 
 // Returns numeric as a string with a specified number of decimal places.
@@ -49,96 +63,511 @@ func (n Numeric) StringDecimalPlaces(dp uint64) string {
 		n = New(0)
 	}
 
-	out := C._str(&n.val[0], C.ulong(dp))
+	return n.str(dp, RoundNearest)
+}
 
-	return C.GoString(out)
+// Returns numeric as a string with a specified number of decimal places, rounding the last digit with the given rounding mode.
+func (n Numeric) StringDecimalPlacesRounded(dp uint64, mode RoundingMode) string {
+	if !n.init {
+		n = New(0)
+	}
+
+	return n.str(dp, mode)
+}
+
+// Returns numeric as a string like String does, without trailing zeros after the decimal point.
+// Example: 5 is 5 instead of 5.0000000000, and 1.23 is 1.23 instead of 1.2300000000.
+func (n Numeric) StringTrimmed() string {
+	return trimZeros(n.String())
+}
+
+// Returns the shortest string that converts back to the same number at its precision, in fixed notation.
+// Example: 5 is 5, 0.1 is 0.1, and 1/3 with 53 precision bits is 0.3333333333333333.
+func (n Numeric) ShortestString() string {
+	if !n.init {
+		n = New(0)
+	}
+
+	digits, exp := n.shortestDecimal()
+
+	sign := ""
+	if strings.HasPrefix(digits, "-") {
+		sign, digits = "-", digits[1:]
+	}
+
+	switch {
+	case exp >= 0:
+		return sign + digits + strings.Repeat("0", exp)
+	case -exp < len(digits):
+		return sign + digits[:len(digits)+exp] + "." + digits[len(digits)+exp:]
+	default:
+		return sign + "0." + strings.Repeat("0", -exp-len(digits)) + digits
+	}
+}
+
+// Canonical is an alias of ShortestString.
+func (n Numeric) Canonical() string {
+	return n.ShortestString()
+}
+
+// Returns numeric as a string with a specified number of significant digits, in fixed notation.
+// Example: 123456 with 3 significant digits is 123000, 0.000123456 is 0.000123, and 1.5 is 1.50.
+func (n Numeric) StringSignificant(sig int) string {
+	if !n.init {
+		n = New(0)
+	}
+
+	if sig < 1 {
+		panic("numeric: Significant digits have to be greater than zero")
+	}
+
+	if C.mpfr_zero_p(&n.val[0]) != 0 {
+		return zeroPadded("0", sig-1)
+	}
+
+	if str, ok := n.nonFinite(); ok {
+		return str
+	}
+
+	digits, exp := n.getStr(sig, RoundNearest)
+
+	sign := ""
+	if strings.HasPrefix(digits, "-") {
+		sign, digits = "-", digits[1:]
+	}
+
+	switch {
+	case exp >= sig:
+		return sign + digits + strings.Repeat("0", exp-sig)
+	case exp > 0:
+		return sign + digits[:exp] + "." + digits[exp:]
+	default:
+		return sign + "0." + strings.Repeat("0", -exp) + digits
+	}
 }
 
 // Returns numeric as int
 func (n Numeric) Int() int {
-	return int(n.getInt())
+	return int(n.getInt(RoundNearest))
 }
 
 // Returns numeric as int8
 func (n Numeric) Int8() int8 {
-	return int8(n.getInt())
+	return int8(n.getInt(RoundNearest))
 }
 
 // Returns numeric as int16
 func (n Numeric) Int16() int16 {
-	return int16(n.getInt())
+	return int16(n.getInt(RoundNearest))
 }
 
 // Returns numeric as int32
 func (n Numeric) Int32() int32 {
-	return int32(n.getInt())
+	return int32(n.getInt(RoundNearest))
 }
 
 // Returns numeric as int64
 func (n Numeric) Int64() int64 {
-	return n.getInt()
+	return n.getInt(RoundNearest)
 }
 
 // Returns numeric as uint
 func (n Numeric) Uint() uint {
-	return uint(n.getUInt())
+	return uint(n.getUInt(RoundNearest))
 }
 
 // Returns numeric as uint8
 func (n Numeric) Uint8() uint8 {
-	return uint8(n.getUInt())
+	return uint8(n.getUInt(RoundNearest))
 }
 
 // Returns numeric as uint16
 func (n Numeric) Uint16() uint16 {
-	return uint16(n.getUInt())
+	return uint16(n.getUInt(RoundNearest))
 }
 
 // Returns numeric as uint32
 func (n Numeric) Uint32() uint32 {
-	return uint32(n.getUInt())
+	return uint32(n.getUInt(RoundNearest))
 }
 
 // Returns numeric as uint64
 func (n Numeric) Uint64() uint64 {
-	return n.getUInt()
+	return n.getUInt(RoundNearest)
 }
 
 // Returns numeric as float32
 func (n Numeric) Float32() float32 {
-	return float32(n.getFloat())
+	return n.getFloat32(RoundNearest)
 }
 
 // Returns numeric as float64
 func (n Numeric) Float64() float64 {
-	return n.getFloat()
+	return n.getFloat(RoundNearest)
+}
+
+// Returns numeric as int64, rounding the fractional part with the given rounding mode
+func (n Numeric) Int64Rounded(mode RoundingMode) int64 {
+	return n.getInt(mode)
+}
+
+// Returns numeric as uint64, rounding the fractional part with the given rounding mode
+func (n Numeric) Uint64Rounded(mode RoundingMode) uint64 {
+	return n.getUInt(mode)
+}
+
+// Returns numeric as float32, rounding with the given rounding mode
+func (n Numeric) Float32Rounded(mode RoundingMode) float32 {
+	return n.getFloat32(mode)
+}
+
+// Returns numeric as float64, rounding with the given rounding mode
+func (n Numeric) Float64Rounded(mode RoundingMode) float64 {
+	return n.getFloat(mode)
+}
+
+// Returns numeric as int, with an error if the number is not an integer or does not fit in int
+func (n Numeric) IntE() (int, error) {
+	x, err := n.checkedInt("int", strconv.IntSize, RoundNearest, true)
+	return int(x), err
+}
+
+// Returns numeric as int8, with an error if the number is not an integer or does not fit in int8
+func (n Numeric) Int8E() (int8, error) {
+	x, err := n.checkedInt("int8", 8, RoundNearest, true)
+	return int8(x), err
+}
+
+// Returns numeric as int16, with an error if the number is not an integer or does not fit in int16
+func (n Numeric) Int16E() (int16, error) {
+	x, err := n.checkedInt("int16", 16, RoundNearest, true)
+	return int16(x), err
+}
+
+// Returns numeric as int32, with an error if the number is not an integer or does not fit in int32
+func (n Numeric) Int32E() (int32, error) {
+	x, err := n.checkedInt("int32", 32, RoundNearest, true)
+	return int32(x), err
+}
+
+// Returns numeric as int64, with an error if the number is not an integer or does not fit in int64
+func (n Numeric) Int64E() (int64, error) {
+	return n.checkedInt("int64", 64, RoundNearest, true)
+}
+
+// Returns numeric as uint, with an error if the number is not an integer or does not fit in uint
+func (n Numeric) UintE() (uint, error) {
+	x, err := n.checkedUint("uint", strconv.IntSize, RoundNearest, true)
+	return uint(x), err
+}
+
+// Returns numeric as uint8, with an error if the number is not an integer or does not fit in uint8
+func (n Numeric) Uint8E() (uint8, error) {
+	x, err := n.checkedUint("uint8", 8, RoundNearest, true)
+	return uint8(x), err
+}
+
+// Returns numeric as uint16, with an error if the number is not an integer or does not fit in uint16
+func (n Numeric) Uint16E() (uint16, error) {
+	x, err := n.checkedUint("uint16", 16, RoundNearest, true)
+	return uint16(x), err
+}
+
+// Returns numeric as uint32, with an error if the number is not an integer or does not fit in uint32
+func (n Numeric) Uint32E() (uint32, error) {
+	x, err := n.checkedUint("uint32", 32, RoundNearest, true)
+	return uint32(x), err
+}
+
+// Returns numeric as uint64, with an error if the number is not an integer or does not fit in uint64
+func (n Numeric) Uint64E() (uint64, error) {
+	return n.checkedUint("uint64", 64, RoundNearest, true)
+}
+
+// Returns numeric as int, rounding the fractional part with the given rounding mode, with an error if the result does not fit in int
+func (n Numeric) IntRoundedE(mode RoundingMode) (int, error) {
+	x, err := n.checkedInt("int", strconv.IntSize, mode, false)
+	return int(x), err
+}
+
+// Returns numeric as int8, rounding the fractional part with the given rounding mode, with an error if the result does not fit in int8
+func (n Numeric) Int8RoundedE(mode RoundingMode) (int8, error) {
+	x, err := n.checkedInt("int8", 8, mode, false)
+	return int8(x), err
+}
+
+// Returns numeric as int16, rounding the fractional part with the given rounding mode, with an error if the result does not fit in int16
+func (n Numeric) Int16RoundedE(mode RoundingMode) (int16, error) {
+	x, err := n.checkedInt("int16", 16, mode, false)
+	return int16(x), err
+}
+
+// Returns numeric as int32, rounding the fractional part with the given rounding mode, with an error if the result does not fit in int32
+func (n Numeric) Int32RoundedE(mode RoundingMode) (int32, error) {
+	x, err := n.checkedInt("int32", 32, mode, false)
+	return int32(x), err
+}
+
+// Returns numeric as int64, rounding the fractional part with the given rounding mode, with an error if the result does not fit in int64
+func (n Numeric) Int64RoundedE(mode RoundingMode) (int64, error) {
+	return n.checkedInt("int64", 64, mode, false)
+}
+
+// Returns numeric as uint, rounding the fractional part with the given rounding mode, with an error if the result does not fit in uint
+func (n Numeric) UintRoundedE(mode RoundingMode) (uint, error) {
+	x, err := n.checkedUint("uint", strconv.IntSize, mode, false)
+	return uint(x), err
+}
+
+// Returns numeric as uint8, rounding the fractional part with the given rounding mode, with an error if the result does not fit in uint8
+func (n Numeric) Uint8RoundedE(mode RoundingMode) (uint8, error) {
+	x, err := n.checkedUint("uint8", 8, mode, false)
+	return uint8(x), err
+}
+
+// Returns numeric as uint16, rounding the fractional part with the given rounding mode, with an error if the result does not fit in uint16
+func (n Numeric) Uint16RoundedE(mode RoundingMode) (uint16, error) {
+	x, err := n.checkedUint("uint16", 16, mode, false)
+	return uint16(x), err
+}
+
+// Returns numeric as uint32, rounding the fractional part with the given rounding mode, with an error if the result does not fit in uint32
+func (n Numeric) Uint32RoundedE(mode RoundingMode) (uint32, error) {
+	x, err := n.checkedUint("uint32", 32, mode, false)
+	return uint32(x), err
+}
+
+// Returns numeric as uint64, rounding the fractional part with the given rounding mode, with an error if the result does not fit in uint64
+func (n Numeric) Uint64RoundedE(mode RoundingMode) (uint64, error) {
+	return n.checkedUint("uint64", 64, mode, false)
 }
 
 // endregion
 
 // region Private
-func (n Numeric) getInt() int64 {
+func (n Numeric) str(dp uint64, rnd RoundingMode) string {
+	// MPFR has no half modes other than ties to even, so the number is rounded to the decimal places first
+	if rnd.isHalf() {
+		n, rnd = n.Round(int(dp), rnd), RoundNearest
+	}
+
+	out := C._str(&n.val[0], C.ulong(dp), rnd.mpfr())
+	defer C.free(unsafe.Pointer(out))
+
+	return C.GoString(out)
+}
+
+// Returns numeric as a string according to the marshal mode.
+func (n Numeric) marshalString() string {
+	switch MarshalStringMode {
+	case MarshalTrimmed:
+		return n.StringTrimmed()
+	case MarshalShortest:
+		return n.ShortestString()
+	default:
+		return n.String()
+	}
+}
+
+// Removes the trailing zeros after the decimal point of a fixed notation string, and the decimal point if nothing is left after it.
+func trimZeros(str string) string {
+	if !strings.Contains(str, ".") {
+		return str
+	}
+
+	str = strings.TrimRight(str, "0")
+	str = strings.TrimSuffix(str, ".")
+
+	if str == "-0" {
+		return "0"
+	}
+
+	return str
+}
+
+// Appends a decimal point and `dp` zeros to an integer string.
+func zeroPadded(integer string, dp int) string {
+	if dp <= 0 {
+		return integer
+	}
+
+	return integer + "." + strings.Repeat("0", dp)
+}
+
+// Returns infinity and NaN the way String writes them, "inf", "-inf" or "nan", and reports whether the number is one of them.
+func (n Numeric) nonFinite() (string, bool) {
+	if !n.init || C.mpfr_number_p(&n.val[0]) != 0 {
+		return "", false
+	}
+
+	return n.str(0, RoundNearest), true
+}
+
+// Returns the shortest decimal digits that convert back to the same number at its precision,
+// so that the number equals digits * 10^exp. The digits have no trailing zeros and start with a minus sign for negative numbers.
+func (n Numeric) shortestDecimal() (string, int) {
+	if C.mpfr_zero_p(&n.val[0]) != 0 {
+		return "0", 0
+	}
+
+	// Infinity and NaN have no digits, they are written like String does
+	if str, ok := n.nonFinite(); ok {
+		return str, 0
+	}
+
+	// Converting back with more digits can only get closer to the number, so the shortest length can be found with a binary search.
+	// The upper bound is the number of digits that MPFR guarantees to convert back.
+	lo, hi := 1, int(C.mpfr_get_str_ndigits(10, C.mpfr_prec_t(n.Precision())))
+	for lo < hi {
+		mid := (lo + hi) / 2
+
+		digits, exp := n.decimalDigits(mid)
+		back, err := newStringWithError(digits+"e"+strconv.Itoa(exp), n.Precision(), RoundNearest)
+		if err == nil && back.Equal(n) {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+
+	return n.decimalDigits(lo)
+}
+
+// Returns the number rounded to `count` significant decimal digits, so that the number is approximately digits * 10^exp.
+// The digits have no trailing zeros and start with a minus sign for negative numbers.
+func (n Numeric) decimalDigits(count int) (string, int) {
+	digits, exp := n.getStr(count, RoundNearest)
+
+	trimmed := strings.TrimRight(digits, "0")
+	if trimmed == "" || trimmed == "-" {
+		return "0", 0
+	}
+
+	return trimmed, exp - count + (len(digits) - len(trimmed))
+}
+
+// Returns the exponent of the most significant decimal digit of the number, e.g. 2 for 123.4 and -3 for 0.00123.
+func (n Numeric) decimalExponent() int {
+	if C.mpfr_regular_p(&n.val[0]) == 0 {
+		return 0
+	}
+
+	// Rounding toward zero never carries into a new digit
+	_, exp := n.getStr(1, RoundTowardZero)
+
+	return exp - 1
+}
+
+// Returns exactly `count` significant decimal digits of the number, so that the number is approximately 0.digits * 10^exp.
+// The digits start with a minus sign for negative numbers.
+func (n Numeric) getStr(count int, rnd RoundingMode) (string, int) {
+	var exp C.mpfr_exp_t
+
+	out := C.mpfr_get_str(nil, &exp, 10, C.size_t(count), &n.val[0], rnd.mpfr())
+	defer C.mpfr_free_str(out)
+
+	return C.GoString(out), int(exp)
+}
+
+func (n Numeric) getInt(rnd RoundingMode) int64 {
+	if !n.init {
+		n = New(0)
+	}
+
+	if rnd.isHalf() {
+		n, rnd = n.Round(0, rnd), RoundNearest
+	}
+
+	return int64(C._get_sj(&n.val[0], rnd.mpfr()))
+}
+
+func (n Numeric) getUInt(rnd RoundingMode) uint64 {
 	if !n.init {
 		n = New(0)
 	}
 
-	return int64(C.mpfr_get_si(&n.val[0], C.MPFR_RNDN))
+	if rnd.isHalf() {
+		n, rnd = n.Round(0, rnd), RoundNearest
+	}
+
+	return uint64(C._get_uj(&n.val[0], rnd.mpfr()))
+}
+
+// Returns numeric as a signed integer of `bitSize` bits named `typ`. The number has to be an integer if `exact` is set,
+// otherwise it is rounded to an integer with the given rounding mode first.
+func (n Numeric) checkedInt(typ string, bitSize int, mode RoundingMode, exact bool) (int64, error) {
+	n, err := n.checkedInteger(mode, exact)
+	if err != nil {
+		return 0, err
+	}
+
+	if C.mpfr_fits_intmax_p(&n.val[0], C.MPFR_RNDN) == 0 {
+		return 0, n.outOfRange(typ)
+	}
+
+	x := n.getInt(RoundNearest)
+	if bitSize < 64 && (x < -1<<(bitSize-1) || x > 1<<(bitSize-1)-1) {
+		return 0, n.outOfRange(typ)
+	}
+
+	return x, nil
+}
+
+// Returns numeric as an unsigned integer of `bitSize` bits named `typ`. See checkedInt.
+func (n Numeric) checkedUint(typ string, bitSize int, mode RoundingMode, exact bool) (uint64, error) {
+	n, err := n.checkedInteger(mode, exact)
+	if err != nil {
+		return 0, err
+	}
+
+	if C.mpfr_fits_uintmax_p(&n.val[0], C.MPFR_RNDN) == 0 {
+		return 0, n.outOfRange(typ)
+	}
+
+	x := n.getUInt(RoundNearest)
+	if bitSize < 64 && x > 1<<bitSize-1 {
+		return 0, n.outOfRange(typ)
+	}
+
+	return x, nil
+}
+
+// Returns the number rounded to an integer with the given rounding mode, or an error if `exact` is set and the number is not an integer.
+func (n Numeric) checkedInteger(mode RoundingMode, exact bool) (Numeric, error) {
+	if !n.init {
+		n = New(0)
+	}
+
+	if !exact {
+		return n.Round(0, mode), nil
+	}
+
+	if !n.IsInt() {
+		return Numeric{}, fmt.Errorf("%w. Got: %s", ErrNotInteger, n.ShortestString())
+	}
+
+	return n, nil
+}
+
+// Returns an error for an integer that does not fit in the type named `typ`, with all the digits of the integer.
+func (n Numeric) outOfRange(typ string) error {
+	return fmt.Errorf("%w. %s does not fit in %s", ErrOutOfRange, n.str(0, RoundNearest), typ)
 }
 
-func (n Numeric) getUInt() uint64 {
+func (n Numeric) getFloat32(rnd RoundingMode) float32 {
 	if !n.init {
 		n = New(0)
 	}
 
-	return uint64(C.mpfr_get_ui(&n.val[0], C.MPFR_RNDN))
+	return float32(C.mpfr_get_flt(&n.val[0], rnd.mpfr()))
 }
 
-func (n Numeric) getFloat() float64 {
+func (n Numeric) getFloat(rnd RoundingMode) float64 {
 	if !n.init {
 		n = New(0)
 	}
 
-	return float64(C.mpfr_get_d(&n.val[0], C.MPFR_RNDN))
+	return float64(C.mpfr_get_d(&n.val[0], rnd.mpfr()))
 }
 
 //export _panic
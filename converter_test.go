@@ -0,0 +1,51 @@
+package numeric
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestInt64E(t *testing.T) {
+	tests := []struct {
+		value string
+		want  int64
+	}{
+		{"0", 0},
+		{"-42", -42},
+		{"9223372036854775807", 9223372036854775807},
+		{"-9223372036854775808", -9223372036854775808},
+	}
+
+	for _, tt := range tests {
+		got, err := NewWithPrecision(tt.value, 64).Int64E()
+		if err != nil || got != tt.want {
+			t.Errorf("New(%q).Int64E() = %d, %v, want %d", tt.value, got, err, tt.want)
+		}
+	}
+
+	got, err := NewWithPrecision("18446744073709551615", 64).Uint64E()
+	if err != nil || got != 18446744073709551615 {
+		t.Errorf("New(\"18446744073709551615\").Uint64E() = %d, %v, want 18446744073709551615", got, err)
+	}
+}
+
+func TestIntegerOutOfRange(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func() error
+		want string
+	}{
+		{"Int64E", func() error { _, err := NewWithPrecision("9223372036854775808", 64).Int64E(); return err }, "9223372036854775808 does not fit in int64"},
+		{"Int64E", func() error { _, err := NewWithPrecision("-9223372036854775809", 70).Int64E(); return err }, "-9223372036854775809 does not fit in int64"},
+		{"Int32E", func() error { _, err := New("1e30").Int32E(); return err }, "1000000000000000019884624838656 does not fit in int32"},
+		{"Uint64E", func() error { _, err := NewWithPrecision("18446744073709551616", 70).Uint64E(); return err }, "18446744073709551616 does not fit in uint64"},
+		{"Uint8E", func() error { _, err := New(-1).Uint8E(); return err }, "-1 does not fit in uint8"},
+	}
+
+	for _, tt := range tests {
+		err := tt.f()
+		if !errors.Is(err, ErrOutOfRange) || err.Error() != ErrOutOfRange.Error()+". "+tt.want {
+			t.Errorf("%s error = %v, want %s", tt.name, err, tt.want)
+		}
+	}
+}
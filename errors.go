@@ -0,0 +1,17 @@
+package numeric
+
+import "errors"
+
+// region Global Variables
+
+// Errors returned by checked conversions and operations. Use errors.Is to check for them, as they may be wrapped with details.
+var (
+	ErrOutOfRange = errors.New("numeric: Value is out of range")   // The value does not fit in the requested type, or is too large to be represented
+	ErrNotInteger = errors.New("numeric: Value is not an integer") // The value has a fractional part where an integer is required
+
+	ErrDivisionByZero = errors.New("numeric: Division by zero")            // The divisor is zero
+	ErrInvalidType    = errors.New("numeric: Invalid type")                // The operand has an unsupported type
+	ErrNotReal        = errors.New("numeric: Result is not a real number") // The result is complex, e.g. the square root of a negative number
+)
+
+// endregion
@@ -0,0 +1,207 @@
+package numeric
+
+/*
+#cgo LDFLAGS: -lmpfr
+#include <mpfr.h>
+*/
+import "C"
+import (
+	"errors"
+	"fmt"
+)
+
+// region Public
+
+// Returns e^n, correctly rounded to the precision of the number. Panics if the result is too large to be represented.
+// This will not modify the original number.
+func (n Numeric) Exp() Numeric {
+	return must(n.ExpE())
+}
+
+// Returns e^n, with an error if the result is too large to be represented. This will not modify the original number.
+func (n Numeric) ExpE() (Numeric, error) {
+	return n.unary(func(result, x *C.__mpfr_struct) { C.mpfr_exp(result, x, C.MPFR_RNDN) })
+}
+
+// Returns 2^n, correctly rounded to the precision of the number. Panics if the result is too large to be represented.
+// This will not modify the original number.
+func (n Numeric) Exp2() Numeric {
+	return must(n.Exp2E())
+}
+
+// Returns 2^n, with an error if the result is too large to be represented. This will not modify the original number.
+func (n Numeric) Exp2E() (Numeric, error) {
+	return n.unary(func(result, x *C.__mpfr_struct) { C.mpfr_exp2(result, x, C.MPFR_RNDN) })
+}
+
+// Returns 10^n, correctly rounded to the precision of the number. Panics if the result is too large to be represented.
+// This will not modify the original number.
+func (n Numeric) Exp10() Numeric {
+	return must(n.Exp10E())
+}
+
+// Returns 10^n, with an error if the result is too large to be represented. This will not modify the original number.
+func (n Numeric) Exp10E() (Numeric, error) {
+	return n.unary(func(result, x *C.__mpfr_struct) { C.mpfr_exp10(result, x, C.MPFR_RNDN) })
+}
+
+// Returns e^n - 1, correctly rounded to the precision of the number, which is accurate even when n is close to zero.
+// Panics if the result is too large to be represented. This will not modify the original number.
+func (n Numeric) Expm1() Numeric {
+	return must(n.Expm1E())
+}
+
+// Returns e^n - 1, with an error if the result is too large to be represented. This will not modify the original number.
+func (n Numeric) Expm1E() (Numeric, error) {
+	return n.unary(func(result, x *C.__mpfr_struct) { C.mpfr_expm1(result, x, C.MPFR_RNDN) })
+}
+
+// Returns the natural logarithm of the number, correctly rounded to the precision of the number.
+// Panics if the number is zero or negative. This will not modify the original number.
+func (n Numeric) Log() Numeric {
+	return must(n.LogE())
+}
+
+// Returns the natural logarithm of the number, with an error if the number is zero or negative. This will not modify the original number.
+func (n Numeric) LogE() (Numeric, error) {
+	if err := n.checkLogarithm("logarithm", 0); err != nil {
+		return Numeric{}, err
+	}
+
+	return n.unary(func(result, x *C.__mpfr_struct) { C.mpfr_log(result, x, C.MPFR_RNDN) })
+}
+
+// Returns the base 2 logarithm of the number, correctly rounded to the precision of the number.
+// Panics if the number is zero or negative. This will not modify the original number.
+func (n Numeric) Log2() Numeric {
+	return must(n.Log2E())
+}
+
+// Returns the base 2 logarithm of the number, with an error if the number is zero or negative. This will not modify the original number.
+func (n Numeric) Log2E() (Numeric, error) {
+	if err := n.checkLogarithm("base 2 logarithm", 0); err != nil {
+		return Numeric{}, err
+	}
+
+	return n.unary(func(result, x *C.__mpfr_struct) { C.mpfr_log2(result, x, C.MPFR_RNDN) })
+}
+
+// Returns the base 10 logarithm of the number, correctly rounded to the precision of the number.
+// Panics if the number is zero or negative. This will not modify the original number.
+func (n Numeric) Log10() Numeric {
+	return must(n.Log10E())
+}
+
+// Returns the base 10 logarithm of the number, with an error if the number is zero or negative. This will not modify the original number.
+func (n Numeric) Log10E() (Numeric, error) {
+	if err := n.checkLogarithm("base 10 logarithm", 0); err != nil {
+		return Numeric{}, err
+	}
+
+	return n.unary(func(result, x *C.__mpfr_struct) { C.mpfr_log10(result, x, C.MPFR_RNDN) })
+}
+
+// Returns the natural logarithm of 1 + n, correctly rounded to the precision of the number, which is accurate even when n is close to zero.
+// Panics if the number is -1 or less. This will not modify the original number.
+func (n Numeric) Log1p() Numeric {
+	return must(n.Log1pE())
+}
+
+// Returns the natural logarithm of 1 + n, with an error if the number is -1 or less. This will not modify the original number.
+func (n Numeric) Log1pE() (Numeric, error) {
+	if err := n.checkLogarithm("log1p", -1); err != nil {
+		return Numeric{}, err
+	}
+
+	return n.unary(func(result, x *C.__mpfr_struct) { C.mpfr_log1p(result, x, C.MPFR_RNDN) })
+}
+
+// Returns the logarithm of the number in base `b`. Bases 2 and 10 are correctly rounded, other bases are computed
+// as log(n) / log(b) with extra precision and rounded once to the precision of the number.
+// Panics if the number is zero or negative, or if the base is not positive or equal to one. This will not modify the original number.
+func (n Numeric) LogBase(b any) Numeric {
+	return must(n.LogBaseE(b))
+}
+
+// Returns the logarithm of the number in base `b`, with an error if the number is zero or negative,
+// if the base is not positive or equal to one, or if the base has an unsupported type. This will not modify the original number.
+func (n Numeric) LogBaseE(b any) (Numeric, error) {
+	if !n.init {
+		n = New(0)
+	}
+
+	base, err := n.operand(b)
+	if err != nil {
+		return Numeric{}, err
+	}
+
+	if !base.GreaterThan(0) || base.Equal(1) {
+		return Numeric{}, errors.New("numeric: Logarithm base has to be greater than zero and not equal to one")
+	}
+
+	switch {
+	case base.Equal(2):
+		return n.Log2E()
+	case base.Equal(10):
+		return n.Log10E()
+	}
+
+	if err := n.checkLogarithm("logarithm", 0); err != nil {
+		return Numeric{}, err
+	}
+
+	// The extra bits make the double rounding of the division harmless in all but the rarest cases
+	bits := n.Precision() + 64
+
+	logN := newEmpty(bits)
+	C.mpfr_log(&logN.val[0], &n.val[0], C.MPFR_RNDN)
+
+	logB := newEmpty(bits)
+	C.mpfr_log(&logB.val[0], &base.val[0], C.MPFR_RNDN)
+
+	return logN.divide(logB, n.Precision(), RoundNearest), nil
+}
+
+// endregion
+
+// region Private
+
+// Applies an MPFR function of one argument to the number, rounding the result to the precision of the number.
+// Returns an error if the result is not a real number or too large to be represented.
+func (n Numeric) unary(f func(result *C.__mpfr_struct, x *C.__mpfr_struct)) (Numeric, error) {
+	if !n.init {
+		n = New(0)
+	}
+
+	result := newEmpty(n.Precision())
+	f(&result.val[0], &n.val[0])
+
+	if C.mpfr_nan_p(&result.val[0]) != 0 {
+		return Numeric{}, fmt.Errorf("%w. Got: %s", ErrNotReal, n.errorString())
+	}
+
+	if C.mpfr_inf_p(&result.val[0]) != 0 {
+		return Numeric{}, fmt.Errorf("%w. The result for %s is too large", ErrOutOfRange, n.errorString())
+	}
+
+	return result, nil
+}
+
+// Checks that the number is greater than `limit`, where a logarithm is negative infinity, and below which it is complex.
+func (n Numeric) checkLogarithm(operation string, limit int) error {
+	if !n.init {
+		n = New(0)
+	}
+
+	if n.Equal(limit) {
+		return fmt.Errorf("%w. The %s of %d is negative infinity", ErrOutOfRange, operation, limit)
+	}
+
+	if n.LessThan(limit) {
+		return n.notReal(operation)
+	}
+
+	return nil
+}
+
+// endregion
@@ -0,0 +1,127 @@
+package numeric
+
+/*
+#cgo LDFLAGS: -lmpfr
+#include <mpfr.h>
+#include <stdlib.h>
+
+extern void _panic(char* msg);
+
+// Formats the number with a printf style format, which has to contain a single "%*.*R*<conversion>" specifier.
+static char* _sprintf(const char* format, int width, int precision, mpfr_rnd_t rnd, mpfr_t num) {
+	// Determine the size needed for the buffer
+	int size_needed = mpfr_snprintf(NULL, 0, format, width, precision, rnd, num);
+	if (size_needed < 0) {
+		_panic("numeric: Error determining buffer size");
+	}
+
+	// Allocate memory for the string
+	char* str = malloc(size_needed + 1);  // +1 for the null terminator
+	if (str == NULL) {
+		_panic("numeric: Unable to allocate memory");
+	}
+
+	mpfr_snprintf(str, size_needed + 1, format, width, precision, rnd, num);
+
+	return str;
+}
+*/
+import "C"
+import (
+	"fmt"
+	"strings"
+	"unsafe"
+)
+
+// region Public
+
+// Format implements the fmt.Formatter interface.
+// Supported verbs are %v and %s (same as %f), %f and %F (fixed notation), %e and %E (scientific notation),
+// %g and %G (shortest of both notations) and %d (rounded to an integer).
+// The width and the '+', '-', ' ', '0' and '#' flags behave like they do for floats, except that '#' has no effect on %d.
+// Without a precision, %v, %s, %f and %F use the default number of decimal places of String,
+// %e and %E print enough digits to convert back to the same number, and %g and %G print 6 significant digits.
+func (n Numeric) Format(s fmt.State, verb rune) {
+	if !n.init {
+		n = New(0)
+	}
+
+	precision, hasPrecision := s.Precision()
+
+	var conversion byte
+	switch verb {
+	case 'v', 's', 'f', 'F':
+		conversion = 'f'
+		if !hasPrecision {
+			precision = int(StringDecimalPlaces)
+		}
+	case 'e', 'E', 'g', 'G':
+		conversion = byte(verb)
+		if !hasPrecision {
+			precision = -1
+		}
+	case 'd':
+		conversion, precision = 'f', 0
+	default:
+		fmt.Fprintf(s, "%%!%c(numeric.Numeric=%s)", verb, n.String())
+		return
+	}
+
+	flags := formatFlags(s)
+	if verb == 'd' {
+		// '#' would keep the decimal point of the fixed notation used for integers
+		flags = strings.ReplaceAll(flags, "#", "")
+	}
+
+	width, _ := s.Width()
+
+	_, _ = s.Write([]byte(n.sprintf(flags, conversion, width, precision)))
+}
+
+// Format implements the fmt.Formatter interface. Null values are formatted as "null", padded to the width.
+// See Numeric.Format for the supported verbs and flags.
+func (n NullNumeric) Format(s fmt.State, verb rune) {
+	if n.Valid {
+		n.Numeric.Format(s, verb)
+		return
+	}
+
+	str := "null"
+	if width, ok := s.Width(); ok && width > len(str) {
+		if s.Flag('-') {
+			str += strings.Repeat(" ", width-len(str))
+		} else {
+			str = strings.Repeat(" ", width-len(str)) + str
+		}
+	}
+
+	_, _ = s.Write([]byte(str))
+}
+
+// endregion
+
+// region Private
+
+func (n Numeric) sprintf(flags string, conversion byte, width int, precision int) string {
+	format := C.CString("%" + flags + "*.*R*" + string(conversion))
+	defer C.free(unsafe.Pointer(format))
+
+	out := C._sprintf(format, C.int(width), C.int(precision), C.MPFR_RNDN, &n.val[0])
+	defer C.free(unsafe.Pointer(out))
+
+	return C.GoString(out)
+}
+
+// Returns the printf flags set in the state.
+func formatFlags(s fmt.State) string {
+	flags := ""
+	for _, flag := range "+- 0#" {
+		if s.Flag(int(flag)) {
+			flags += string(flag)
+		}
+	}
+
+	return flags
+}
+
+// endregion
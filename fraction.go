@@ -0,0 +1,196 @@
+package numeric
+
+/*
+#cgo LDFLAGS: -lmpfr
+#include <mpfr.h>
+*/
+import "C"
+
+// region Public
+
+// Returns the fraction closest to the number whose denominator is at most `maxDenominator`, as a numerator and a positive denominator.
+// Example: 0.3333333333 with a maximum denominator of 100 is 1/3, and pi with 1000 is 355/113.
+// Numbers are binary fractions, so with a large enough maximum denominator the result is the exact value of the number, e.g. 0.5 is 1/2.
+// The numerator and the denominator are integers of any size. Pass a Numeric as the maximum denominator for values beyond uint64.
+// Infinity is returned as the numerator over a denominator of one.
+func (n Numeric) ToFraction(maxDenominator any) (Numeric, Numeric) {
+	if !n.init {
+		n = New(0)
+	}
+
+	maxDen := integerOperand(maxDenominator)
+	if !maxDen.init || maxDen.LessThan(1) {
+		panic("numeric: Maximum denominator has to be at least one")
+	}
+	maxDen = floorDivide(maxDen, New(1))
+
+	num, den := exactFraction(n)
+	if den.LessThanOrEqual(maxDen) {
+		return withDefaultPrecision(num), withDefaultPrecision(den)
+	}
+
+	// Walk the convergents of the continued fraction until the denominator gets too large
+	p0, q0, p1, q1 := New(0), New(1), New(1), New(0)
+	for {
+		a := floorDivide(num, den)
+
+		q2 := exactIntegerAdd(q0, exactMultiply(a, q1))
+		if q2.GreaterThan(maxDen) {
+			break
+		}
+
+		p0, q0, p1, q1 = p1, q1, exactIntegerAdd(p0, exactMultiply(a, p1)), q2
+		num, den = den, exactIntegerSubtract(num, exactMultiply(a, den))
+	}
+
+	// The best approximation is either the last convergent or the largest semiconvergent that fits
+	k := floorDivide(exactIntegerSubtract(maxDen, q0), q1)
+	p2, q2 := exactIntegerAdd(p0, exactMultiply(k, p1)), exactIntegerAdd(q0, exactMultiply(k, q1))
+
+	// Compare |p1/q1 - x| with |p2/q2 - x| without dividing, x being the exact fraction of the number
+	xNum, xDen := exactFraction(n)
+	dist1 := exactIntegerSubtract(exactMultiply(p1, xDen), exactMultiply(xNum, q1)).Abs()
+	dist2 := exactIntegerSubtract(exactMultiply(p2, xDen), exactMultiply(xNum, q2)).Abs()
+
+	if exactMultiply(dist1, q2).LessThanOrEqual(exactMultiply(dist2, q1)) {
+		return withDefaultPrecision(p1), withDefaultPrecision(q1)
+	}
+
+	return withDefaultPrecision(p2), withDefaultPrecision(q2)
+}
+
+// Returns up to `terms` terms of the continued fraction expansion of the number, e.g. [3 7 15 1 292] for pi with 5 terms.
+// The first term is the floor of the number and the other terms are positive integers of any size.
+// Numbers are binary fractions, so the expansion is finite and may have fewer terms than requested. Infinity is its only term.
+func (n Numeric) ContinuedFraction(terms int) []Numeric {
+	if !n.init {
+		n = New(0)
+	}
+
+	if terms < 1 {
+		panic("numeric: Number of terms has to be greater than zero")
+	}
+
+	num, den := exactFraction(n)
+	if C.mpfr_number_p(&num.val[0]) == 0 {
+		return []Numeric{withDefaultPrecision(num)}
+	}
+
+	result := []Numeric{}
+	for len(result) < terms {
+		a := floorDivide(num, den)
+		result = append(result, withDefaultPrecision(a))
+
+		remainder := exactIntegerSubtract(num, exactMultiply(a, den))
+		if remainder.Equal(0) {
+			break
+		}
+
+		num, den = den, remainder
+	}
+
+	return result
+}
+
+// Creates a new numeric value from a fraction, such as FromFraction(1, 3) for 1/3. The division is rounded once.
+// The precision is PrecisionBits, or the precision of a Numeric numerator or denominator if it is larger.
+// Pass Numeric values for integers beyond uint64.
+func FromFraction(numerator any, denominator any) Numeric {
+	num, den := integerOperand(numerator), integerOperand(denominator)
+	if !num.init {
+		num = New(0)
+	}
+
+	if !den.init || den.Equal(0) {
+		panic("numeric: Division by zero")
+	}
+
+	// Integers are converted with all their bits, but only Numeric operands bring their own precision to the result
+	bits := PrecisionBits
+	for _, x := range []any{numerator, denominator} {
+		if x, ok := x.(Numeric); ok && x.init {
+			bits = max(bits, x.Precision())
+		}
+	}
+
+	return num.divide(den, bits, RoundNearest)
+}
+
+// endregion
+
+// region Private
+
+// Returns the number as a fraction in lowest terms with a positive denominator, which is a power of two.
+// Infinity and NaN are returned unchanged over a denominator of one.
+func exactFraction(n Numeric) (Numeric, Numeric) {
+	if C.mpfr_zero_p(&n.val[0]) != 0 {
+		return New(0), New(1)
+	}
+
+	if C.mpfr_number_p(&n.val[0]) == 0 {
+		return newNumeric(n, n.Precision(), RoundNearest), New(1)
+	}
+
+	// A number with m significant bits whose most significant bit has the weight 2^(e-1) is an odd integer times 2^(e-m)
+	bits := int64(C.mpfr_min_prec(&n.val[0]))
+	shift := bits - int64(C.mpfr_get_exp(&n.val[0]))
+
+	if shift <= 0 {
+		return newNumeric(n, n.Precision(), RoundNearest), New(1)
+	}
+
+	num := newEmpty(uint64(bits))
+	C.mpfr_mul_2si(&num.val[0], &n.val[0], C.long(shift), C.MPFR_RNDN)
+
+	den := newEmpty(1)
+	C.mpfr_set_ui_2exp(&den.val[0], 1, C.mpfr_exp_t(shift), C.MPFR_RNDN)
+
+	return num, den
+}
+
+// Returns floor(a / b) for integers a and b.
+func floorDivide(a Numeric, b Numeric) Numeric {
+	// |a / b| <= |a| for integers, so the floor is representable with the bits of a,
+	// and rounding the quotient down at that precision never goes below it
+	result := newEmpty(integerBits(a) + 1)
+	C.mpfr_div(&result.val[0], &a.val[0], &b.val[0], C.MPFR_RNDD)
+	C.mpfr_floor(&result.val[0], &result.val[0])
+
+	return result
+}
+
+// Returns a + b exactly for integers a and b.
+func exactIntegerAdd(a Numeric, b Numeric) Numeric {
+	return a.add(b, max(integerBits(a), integerBits(b))+1, RoundNearest)
+}
+
+// Returns a - b exactly for integers a and b.
+func exactIntegerSubtract(a Numeric, b Numeric) Numeric {
+	return a.subtract(b, max(integerBits(a), integerBits(b))+1, RoundNearest)
+}
+
+// Returns the number of bits of the integer part of the number.
+func integerBits(n Numeric) uint64 {
+	if C.mpfr_regular_p(&n.val[0]) == 0 || C.mpfr_get_exp(&n.val[0]) < 1 {
+		return 1
+	}
+
+	return uint64(C.mpfr_get_exp(&n.val[0]))
+}
+
+// Returns the number with at least PrecisionBits, so integers with few bits can be used in further arithmetic without losing precision.
+func withDefaultPrecision(n Numeric) Numeric {
+	return newNumeric(n, max(PrecisionBits, n.Precision()), RoundNearest)
+}
+
+// Converts an operand to a numeric value, keeping all the bits of 64-bit integers.
+func integerOperand(x any) Numeric {
+	switch x := x.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return NewWithPrecision(x, 64)
+	}
+
+	return New(x)
+}
+
+// endregion
@@ -0,0 +1,102 @@
+package numeric
+
+import "testing"
+
+func TestToFraction(t *testing.T) {
+	pi := "3.14159265358979323846"
+
+	tests := []struct {
+		value          string
+		maxDenominator int
+		numerator      string
+		denominator    string
+	}{
+		// Convergents
+		{pi, 1000, "355", "113"},
+		{pi, 10, "22", "7"},
+		{pi, 7, "22", "7"},
+		{"0.3333333333", 100, "1", "3"},
+		{"1.41421356237", 50, "41", "29"},
+		{"2.718281828459045", 10, "19", "7"},
+
+		// Semiconvergents that are closer than the last convergent
+		{pi, 100, "311", "99"},
+		{"-" + pi, 100, "-311", "99"},
+		{"2.718281828459045", 100, "193", "71"},
+		{"0.1", 9, "1", "9"},
+		{"-0.75", 3, "-2", "3"},
+		{"0.66", 2, "1", "2"},
+
+		// Exact values and integers
+		{"0.5", 1000, "1", "2"},
+		{"0.1", 10, "1", "10"},
+		{"0.999", 10, "1", "1"},
+		{"7", 5, "7", "1"},
+		{"0", 5, "0", "1"},
+	}
+
+	for _, tt := range tests {
+		num, den := New(tt.value).ToFraction(tt.maxDenominator)
+		if num.ShortestString() != tt.numerator || den.ShortestString() != tt.denominator {
+			t.Errorf("New(%q).ToFraction(%d) = %s/%s, want %s/%s",
+				tt.value, tt.maxDenominator, num.ShortestString(), den.ShortestString(), tt.numerator, tt.denominator)
+		}
+	}
+}
+
+func TestContinuedFraction(t *testing.T) {
+	tests := []struct {
+		value string
+		terms int
+		want  []string
+	}{
+		{"3.14159265358979323846", 5, []string{"3", "7", "15", "1", "292"}},
+		{"0.75", 10, []string{"0", "1", "3"}},
+		{"-0.75", 10, []string{"-1", "4"}},
+		{"7", 3, []string{"7"}},
+	}
+
+	for _, tt := range tests {
+		got := New(tt.value).ContinuedFraction(tt.terms)
+
+		strs := make([]string, len(got))
+		for i, term := range got {
+			strs[i] = term.ShortestString()
+		}
+
+		if len(strs) != len(tt.want) {
+			t.Errorf("New(%q).ContinuedFraction(%d) = %v, want %v", tt.value, tt.terms, strs, tt.want)
+			continue
+		}
+
+		for i := range strs {
+			if strs[i] != tt.want[i] {
+				t.Errorf("New(%q).ContinuedFraction(%d) = %v, want %v", tt.value, tt.terms, strs, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestFromFraction(t *testing.T) {
+	tests := []struct {
+		numerator   any
+		denominator any
+		want        string
+	}{
+		{1, 4, "0.25"},
+		{-3, 8, "-0.375"},
+		{uint64(1) << 63, uint64(1) << 62, "2"},
+		{New("100000000000000000000"), 4, "25000000000000000000"},
+	}
+
+	for _, tt := range tests {
+		if got := FromFraction(tt.numerator, tt.denominator).ShortestString(); got != tt.want {
+			t.Errorf("FromFraction(%v, %v) = %s, want %s", tt.numerator, tt.denominator, got, tt.want)
+		}
+	}
+
+	if got, want := FromFraction(1, 3), New(1).Divide(3); !got.Equal(want) {
+		t.Errorf("FromFraction(1, 3) = %s, want %s", got.String(), want.String())
+	}
+}
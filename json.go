@@ -24,7 +24,7 @@ func (n *Numeric) UnmarshalJSON(bytes []byte) error {
 
 // MarshalJSON implements the json.Marshaler interface.
 func (n Numeric) MarshalJSON() ([]byte, error) {
-	str := "\"" + n.String() + "\""
+	str := "\"" + n.marshalString() + "\""
 	return []byte(str), nil
 }
 
@@ -54,6 +54,6 @@ func (n NullNumeric) MarshalJSON() ([]byte, error) {
 		return []byte("null"), nil
 	}
 
-	str := "\"" + n.Numeric.String() + "\""
+	str := "\"" + n.Numeric.marshalString() + "\""
 	return []byte(str), nil
 }
@@ -0,0 +1,267 @@
+package numeric
+
+import (
+	"errors"
+	"strings"
+	"sync"
+)
+
+// Determines how negative numbers are written by a locale.
+type NegativeStyle int
+
+const (
+	NegativeLeadingSign  NegativeStyle = iota // -1,234.56
+	NegativeTrailingSign                      // 1,234.56-
+	NegativeParentheses                       // (1,234.56), as used in accounting
+)
+
+// Locale describes how numbers are written in a region.
+type Locale struct {
+	GroupSize          int           // Number of digits in the group next to the decimal separator, 0 disables grouping
+	SecondaryGroupSize int           // Number of digits in the other groups, 0 means the same as GroupSize. Indian grouping uses 3 and 2
+	GroupSeparator     string        // Separator between groups of digits, e.g. "," in 1,234,567
+	DecimalSeparator   string        // Separator between the integer and the fractional part, e.g. "." in 1.5
+	MinusSign          string        // Sign of negative numbers, "-" if empty
+	NegativeStyle      NegativeStyle // Placement of the sign of negative numbers
+}
+
+// region Global Variables
+var (
+	localesMu sync.RWMutex
+	locales   = map[string]Locale{
+		"en-US": {GroupSize: 3, GroupSeparator: ",", DecimalSeparator: "."},
+		"en-GB": {GroupSize: 3, GroupSeparator: ",", DecimalSeparator: "."},
+		"en-AU": {GroupSize: 3, GroupSeparator: ",", DecimalSeparator: "."},
+		"en-SG": {GroupSize: 3, GroupSeparator: ",", DecimalSeparator: "."},
+		"en-IN": {GroupSize: 3, SecondaryGroupSize: 2, GroupSeparator: ",", DecimalSeparator: "."},
+		"hi-IN": {GroupSize: 3, SecondaryGroupSize: 2, GroupSeparator: ",", DecimalSeparator: "."},
+		"id-ID": {GroupSize: 3, GroupSeparator: ".", DecimalSeparator: ","},
+		"ms-MY": {GroupSize: 3, GroupSeparator: ",", DecimalSeparator: "."},
+		"de-DE": {GroupSize: 3, GroupSeparator: ".", DecimalSeparator: ","},
+		"de-AT": {GroupSize: 3, GroupSeparator: "\u00a0", DecimalSeparator: ","},
+		"de-CH": {GroupSize: 3, GroupSeparator: "\u2019", DecimalSeparator: "."},
+		"fr-FR": {GroupSize: 3, GroupSeparator: "\u202f", DecimalSeparator: ","},
+		"fr-CH": {GroupSize: 3, GroupSeparator: "\u202f", DecimalSeparator: ","},
+		"it-IT": {GroupSize: 3, GroupSeparator: ".", DecimalSeparator: ","},
+		"es-ES": {GroupSize: 3, GroupSeparator: ".", DecimalSeparator: ","},
+		"es-MX": {GroupSize: 3, GroupSeparator: ",", DecimalSeparator: "."},
+		"pt-BR": {GroupSize: 3, GroupSeparator: ".", DecimalSeparator: ","},
+		"pt-PT": {GroupSize: 3, GroupSeparator: "\u00a0", DecimalSeparator: ","},
+		"nl-NL": {GroupSize: 3, GroupSeparator: ".", DecimalSeparator: ","},
+		"sv-SE": {GroupSize: 3, GroupSeparator: "\u00a0", DecimalSeparator: ",", MinusSign: "\u2212"},
+		"nb-NO": {GroupSize: 3, GroupSeparator: "\u00a0", DecimalSeparator: ",", MinusSign: "\u2212"},
+		"da-DK": {GroupSize: 3, GroupSeparator: ".", DecimalSeparator: ","},
+		"fi-FI": {GroupSize: 3, GroupSeparator: "\u00a0", DecimalSeparator: ",", MinusSign: "\u2212"},
+		"pl-PL": {GroupSize: 3, GroupSeparator: "\u00a0", DecimalSeparator: ","},
+		"ru-RU": {GroupSize: 3, GroupSeparator: "\u00a0", DecimalSeparator: ","},
+		"tr-TR": {GroupSize: 3, GroupSeparator: ".", DecimalSeparator: ","},
+		"ja-JP": {GroupSize: 3, GroupSeparator: ",", DecimalSeparator: "."},
+		"zh-CN": {GroupSize: 3, GroupSeparator: ",", DecimalSeparator: "."},
+		"ko-KR": {GroupSize: 3, GroupSeparator: ",", DecimalSeparator: "."},
+		"th-TH": {GroupSize: 3, GroupSeparator: ",", DecimalSeparator: "."},
+		"vi-VN": {GroupSize: 3, GroupSeparator: ".", DecimalSeparator: ","},
+	}
+)
+
+// endregion
+
+// region Public
+
+// Registers a locale under a name, such as "en-US", replacing any locale registered under the same name.
+func RegisterLocale(name string, locale Locale) {
+	localesMu.Lock()
+	defer localesMu.Unlock()
+
+	locales[name] = locale
+}
+
+// Returns the locale registered under a name, such as "en-US" or "id-ID".
+func GetLocale(name string) (Locale, bool) {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	locale, ok := locales[name]
+	return locale, ok
+}
+
+// Returns numeric as a string with a specified number of decimal places, written the way the locale writes numbers.
+// Example: 1234567.891 with 2 decimal places is 1,234,567.89 in en-US, 1.234.567,89 in id-ID and 12,34,567.89 in en-IN.
+func (n Numeric) StringLocale(locale Locale, dp uint64) string {
+	if !n.init {
+		n = New(0)
+	}
+
+	str := n.str(dp, RoundNearest)
+
+	negative := strings.HasPrefix(str, "-")
+	str = strings.TrimPrefix(str, "-")
+
+	integer, fraction, _ := strings.Cut(str, ".")
+
+	out := locale.group(integer)
+	if fraction != "" {
+		out += locale.DecimalSeparator + fraction
+	}
+
+	// Do not show a sign for numbers that were rounded to zero
+	if !negative || strings.Trim(str, "0.") == "" {
+		return out
+	}
+
+	minus := locale.MinusSign
+	if minus == "" {
+		minus = "-"
+	}
+
+	switch locale.NegativeStyle {
+	case NegativeTrailingSign:
+		return out + minus
+	case NegativeParentheses:
+		return "(" + out + ")"
+	default:
+		return minus + out
+	}
+}
+
+// Parses a number written the way the locale writes numbers, such as "1.234,50" in id-ID or "1,234.50" in en-US.
+// Besides the locale's own separators and sign, it understands accounting parentheses for negative numbers, e.g. "(1,234.00)",
+// leading and trailing signs, e.g. "-5" and "5-", and a percent suffix, e.g. "12%" which is 0.12.
+// Any kind of space is accepted as a group separator when the locale groups digits with spaces, e.g. "1 234,5" in fr-FR.
+// Digit groups have to match the group sizes of the locale, so "1,5" in en-US is an error rather than 15.
+func ParseLocale(s string, locale Locale) (Numeric, error) {
+	str := strings.TrimSpace(s)
+	negative, parentheses := false, false
+
+	if strings.HasPrefix(str, "(") && strings.HasSuffix(str, ")") {
+		str, negative, parentheses = strings.TrimSpace(str[1:len(str)-1]), true, true
+	}
+
+	exp := 0
+	if strings.HasSuffix(str, "%") {
+		str, exp = strings.TrimSpace(strings.TrimSuffix(str, "%")), -2
+	}
+
+	signs := []string{"-", "\u2212", "+"}
+	if locale.MinusSign != "" {
+		signs = append([]string{locale.MinusSign}, signs...)
+	}
+
+	for _, sign := range signs {
+		if !strings.HasPrefix(str, sign) && !strings.HasSuffix(str, sign) {
+			continue
+		}
+
+		// A sign inside accounting parentheses is ambiguous, e.g. "(-5)" could mean -5 or 5
+		if parentheses {
+			return Numeric{}, errors.New("numeric: Invalid string. Negative numbers in parentheses cannot have a sign")
+		}
+
+		if strings.HasPrefix(str, sign) {
+			str = strings.TrimSpace(strings.TrimPrefix(str, sign))
+		} else {
+			str = strings.TrimSpace(strings.TrimSuffix(str, sign))
+		}
+		negative = sign != "+"
+
+		break
+	}
+
+	integer, fraction, hasFraction := str, "", false
+	if locale.DecimalSeparator != "" {
+		integer, fraction, hasFraction = strings.Cut(str, locale.DecimalSeparator)
+	}
+
+	integer, err := locale.ungroup(integer)
+	if err != nil {
+		return Numeric{}, err
+	}
+
+	str = integer
+	if hasFraction {
+		str += "." + fraction
+	}
+
+	// Only plain digits are expected at this point, exponents and digit separators of the numeric grammar are not locale specific
+	if strings.ContainsAny(str, "eE_+-") || !numericStringRegex.MatchString(str) {
+		return Numeric{}, errors.New("numeric: Invalid string. String has to be a number written in the given locale")
+	}
+
+	if negative {
+		str = "-" + str
+	}
+
+	return newScaledStringWithError(str, exp)
+}
+
+// endregion
+
+// region Private
+
+// Returns the group separators accepted when parsing. Any kind of space is accepted for locales that group digits with spaces.
+func (l Locale) groupSeparators() []string {
+	if l.GroupSeparator == "" {
+		return nil
+	}
+
+	if strings.TrimSpace(l.GroupSeparator) == "" || l.GroupSeparator == "\u202f" {
+		return []string{l.GroupSeparator, " ", "\u00a0", "\u202f"}
+	}
+
+	return []string{l.GroupSeparator}
+}
+
+// Removes the group separators from the integer part of a number, checking that the groups have the sizes of the locale,
+// so that a decimal written with the wrong separator, e.g. "1,5" in en-US, is not read as a much larger number.
+func (l Locale) ungroup(integer string) (string, error) {
+	for _, separator := range l.groupSeparators() {
+		integer = strings.ReplaceAll(integer, separator, l.GroupSeparator)
+	}
+
+	if l.GroupSeparator == "" || !strings.Contains(integer, l.GroupSeparator) {
+		return integer, nil
+	}
+
+	secondary := l.SecondaryGroupSize
+	if secondary <= 0 {
+		secondary = l.GroupSize
+	}
+
+	groups := strings.Split(integer, l.GroupSeparator)
+	for i, group := range groups {
+		size := secondary
+		if i == len(groups)-1 {
+			size = l.GroupSize
+		}
+
+		// The first group may be shorter than the others, but not empty
+		if l.GroupSize <= 0 || len(group) > size || (i > 0 && len(group) != size) || group == "" {
+			return "", errors.New("numeric: Invalid string. Digit groups do not match the group sizes of the locale")
+		}
+	}
+
+	return strings.Join(groups, ""), nil
+}
+
+// Inserts group separators into a string of integer digits.
+func (l Locale) group(integer string) string {
+	if l.GroupSize <= 0 || len(integer) <= l.GroupSize {
+		return integer
+	}
+
+	secondary := l.SecondaryGroupSize
+	if secondary <= 0 {
+		secondary = l.GroupSize
+	}
+
+	groups := []string{integer[len(integer)-l.GroupSize:]}
+	rest := integer[:len(integer)-l.GroupSize]
+
+	for len(rest) > secondary {
+		groups = append([]string{rest[len(rest)-secondary:]}, groups...)
+		rest = rest[:len(rest)-secondary]
+	}
+
+	return strings.Join(append([]string{rest}, groups...), l.GroupSeparator)
+}
+
+// endregion
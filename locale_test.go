@@ -0,0 +1,91 @@
+package numeric
+
+import "testing"
+
+func TestParseLocale(t *testing.T) {
+	tests := []struct {
+		str    string
+		locale string
+		want   string
+	}{
+		{"1,234.50", "en-US", "1234.5"},
+		{"1234.5", "en-US", "1234.5"},
+		{"1,234,567", "en-US", "1234567"},
+		{"999", "en-US", "999"},
+		{"1.234,50", "id-ID", "1234.5"},
+		{"1.234.567,89", "id-ID", "1234567.89"},
+		{"12,34,567.5", "en-IN", "1234567.5"},
+		{"1,234.5", "en-IN", "1234.5"},
+		{"1 234,5", "fr-FR", "1234.5"},
+		{"1\u00a0234,5", "fr-FR", "1234.5"},
+		{"1\u202f234,5", "fr-FR", "1234.5"},
+		{"1\u2019234.5", "de-CH", "1234.5"},
+
+		// Signs and parentheses
+		{"-5", "en-US", "-5"},
+		{"+5", "en-US", "5"},
+		{"5-", "en-US", "-5"},
+		{"\u22125", "en-US", "-5"},
+		{"\u22121 234", "sv-SE", "-1234"},
+		{"(1,234.00)", "en-US", "-1234"},
+		{"( 5 )", "en-US", "-5"},
+
+		// Percentages
+		{"12%", "en-US", "0.12"},
+		{"12,5 %", "de-DE", "0.125"},
+		{"(50%)", "en-US", "-0.5"},
+	}
+
+	for _, tt := range tests {
+		locale, _ := GetLocale(tt.locale)
+
+		n, err := ParseLocale(tt.str, locale)
+		if err != nil {
+			t.Errorf("ParseLocale(%q, %s) returned error: %v", tt.str, tt.locale, err)
+			continue
+		}
+
+		if got := n.ShortestString(); got != tt.want {
+			t.Errorf("ParseLocale(%q, %s) = %s, want %s", tt.str, tt.locale, got, tt.want)
+		}
+	}
+}
+
+func TestParseLocaleInvalid(t *testing.T) {
+	tests := []struct {
+		str    string
+		locale string
+	}{
+		// A decimal written with the wrong separator is not read as a larger number
+		{"1,5", "en-US"},
+		{"1.5", "id-ID"},
+		{"1,2,3", "en-US"},
+		{"1,2345", "en-US"},
+		{"1234,567", "en-US"},
+		{",234", "en-US"},
+		{"1,,234", "en-US"},
+		{"1,234,567", "en-IN"},
+		{"1,234.5", "id-ID"},
+
+		// Signs inside parentheses are ambiguous
+		{"(-5)", "en-US"},
+		{"(\u22125)", "en-US"},
+		{"(+5)", "en-US"},
+		{"(5-)", "en-US"},
+
+		{"", "en-US"},
+		{"abc", "en-US"},
+		{"1e5", "en-US"},
+		{"1_000", "en-US"},
+		{"--5", "en-US"},
+		{"1.2.3", "en-US"},
+	}
+
+	for _, tt := range tests {
+		locale, _ := GetLocale(tt.locale)
+
+		if n, err := ParseLocale(tt.str, locale); err == nil {
+			t.Errorf("ParseLocale(%q, %s) = %s, want error", tt.str, tt.locale, n.ShortestString())
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package numeric
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// region Global Variables
+
+// SI prefixes by their power of ten.
+var siPrefixes = map[int]string{
+	-30: "q", -27: "r", -24: "y", -21: "z", -18: "a", -15: "f", -12: "p", -9: "n", -6: "µ", -3: "m",
+	0: "", 3: "k", 6: "M", 9: "G", 12: "T", 15: "P", 18: "E", 21: "Z", 24: "Y", 27: "R", 30: "Q",
+}
+
+// Powers of ten by SI prefix, including the alternative spellings of micro.
+var siExponents = map[string]int{
+	"q": -30, "r": -27, "y": -24, "z": -21, "a": -18, "f": -15, "p": -12, "n": -9, "µ": -6, "μ": -6, "u": -6, "m": -3,
+	"k": 3, "M": 6, "G": 9, "T": 12, "P": 15, "E": 18, "Z": 21, "Y": 24, "R": 27, "Q": 30,
+}
+
+// endregion
+
+// region Public
+
+// Returns numeric as a string in scientific notation with a specified number of significant digits.
+// Example: 0.00000000000000123 with 3 significant digits is 1.23e-15, and 123456 is 1.23e5. Infinity is inf.
+func (n Numeric) StringScientific(sig int) string {
+	if str, ok := n.nonFinite(); ok {
+		return str
+	}
+
+	sign, digits, exp := n.significand(sig)
+
+	return sign + pointAfter(digits, 1) + "e" + strconv.Itoa(exp)
+}
+
+// Returns numeric as a string in engineering notation with a specified number of significant digits,
+// where the exponent is a multiple of three. Example: 12345 with 3 significant digits is 12.3e3.
+func (n Numeric) StringEngineering(sig int) string {
+	if str, ok := n.nonFinite(); ok {
+		return str
+	}
+
+	sign, digits, exp := n.significand(sig)
+	integerDigits, exp3 := engineeringExponent(exp)
+
+	return sign + pointAfter(digits, integerDigits) + "e" + strconv.Itoa(exp3)
+}
+
+// Returns numeric as a string with a specified number of significant digits, an SI prefix and a unit.
+// Example: 0.00000456 with 3 significant digits and the unit "V" is 4.56 µV, and 7800000 with 2 significant digits and no unit is 7.8 M.
+// Numbers beyond the range of the SI prefixes use the smallest or largest prefix.
+func (n Numeric) StringSI(sig int, unit string) string {
+	if str, ok := n.nonFinite(); ok {
+		return strings.TrimSuffix(str+" "+unit, " ")
+	}
+
+	sign, digits, exp := n.significand(sig)
+	integerDigits, exp3 := engineeringExponent(exp)
+
+	if exp3 < -30 {
+		integerDigits, exp3 = integerDigits+exp3+30, -30
+	} else if exp3 > 30 {
+		integerDigits, exp3 = integerDigits+exp3-30, 30
+	}
+
+	suffix := siPrefixes[exp3] + unit
+	if suffix == "" {
+		return sign + pointAfter(digits, integerDigits)
+	}
+
+	return sign + pointAfter(digits, integerDigits) + " " + suffix
+}
+
+// Parses a string with an optional SI prefix and unit into a numeric value, e.g. "4.56 µV" with the unit "V", or "7.8M" with no unit.
+// Micro can be written as "µ", "μ" or "u". The number can be separated from the prefix by spaces.
+func ParseSI(s string, unit string) (Numeric, error) {
+	str := strings.TrimSpace(s)
+
+	if !strings.HasSuffix(str, unit) {
+		return Numeric{}, errors.New("numeric: Invalid SI string. String has to end with the unit '" + unit + "'")
+	}
+	str = strings.TrimSpace(strings.TrimSuffix(str, unit))
+
+	exp := 0
+	for prefix, prefixExp := range siExponents {
+		number := strings.TrimSpace(strings.TrimSuffix(str, prefix))
+		if strings.HasSuffix(str, prefix) && numericStringRegex.MatchString(number) {
+			str, exp = number, prefixExp
+			break
+		}
+	}
+
+	if !numericStringRegex.MatchString(str) {
+		return Numeric{}, errors.New("numeric: Invalid SI string. String has to be numerical, followed by an optional SI prefix")
+	}
+
+	return newScaledStringWithError(str, exp)
+}
+
+// endregion
+
+// region Private
+
+// Returns the sign, `sig` significant digits and the decimal exponent of the number, so that the number is approximately d.ddd * 10^exp.
+func (n Numeric) significand(sig int) (string, string, int) {
+	if !n.init {
+		n = New(0)
+	}
+
+	if sig < 1 {
+		panic("numeric: Significant digits have to be greater than zero")
+	}
+
+	if n.Equal(0) {
+		return "", strings.Repeat("0", sig), 0
+	}
+
+	digits, exp := n.getStr(sig, RoundNearest)
+
+	sign := ""
+	if strings.HasPrefix(digits, "-") {
+		sign, digits = "-", digits[1:]
+	}
+
+	return sign, digits, exp - 1
+}
+
+// Creates a new numeric value from a numeric string multiplied by 10^exp.
+// The exponent of the string is shifted instead of multiplying, so the number is only rounded once.
+func newScaledStringWithError(str string, exp int) (Numeric, error) {
+	if i := strings.IndexAny(str, "eE"); i >= 0 {
+		strExp, err := strconv.Atoi(strings.ReplaceAll(str[i+1:], "_", ""))
+		if err != nil {
+			return Numeric{}, errors.New("numeric: Invalid string. Exponent is out of range")
+		}
+
+		str, exp = str[:i], exp+strExp
+	}
+
+	return newStringWithError(str+"e"+strconv.Itoa(exp), PrecisionBits, RoundNearest)
+}
+
+// Returns the number of integer digits and the exponent of the engineering notation of d.ddd * 10^exp.
+func engineeringExponent(exp int) (int, int) {
+	exp3 := exp - ((exp%3)+3)%3
+
+	return exp - exp3 + 1, exp3
+}
+
+// Places a decimal point after `integerDigits` digits, padding with zeros if there are not enough digits.
+func pointAfter(digits string, integerDigits int) string {
+	if integerDigits <= 0 {
+		return "0." + strings.Repeat("0", -integerDigits) + digits
+	}
+
+	if integerDigits >= len(digits) {
+		return digits + strings.Repeat("0", integerDigits-len(digits))
+	}
+
+	return digits[:integerDigits] + "." + digits[integerDigits:]
+}
+
+// endregion
@@ -18,31 +18,31 @@ func NewNull(x any) NullNumeric {
 	case Numeric:
 		return NullNumeric{true, x}
 	case int:
-		return NullNumeric{true, newInt(int64(x))}
+		return NullNumeric{true, newInt(int64(x), PrecisionBits, RoundNearest)}
 	case int8:
-		return NullNumeric{true, newInt(int64(x))}
+		return NullNumeric{true, newInt(int64(x), PrecisionBits, RoundNearest)}
 	case int16:
-		return NullNumeric{true, newInt(int64(x))}
+		return NullNumeric{true, newInt(int64(x), PrecisionBits, RoundNearest)}
 	case int32:
-		return NullNumeric{true, newInt(int64(x))}
+		return NullNumeric{true, newInt(int64(x), PrecisionBits, RoundNearest)}
 	case int64:
-		return NullNumeric{true, newInt(x)}
+		return NullNumeric{true, newInt(x, PrecisionBits, RoundNearest)}
 	case uint:
-		return NullNumeric{true, newUint(uint64(x))}
+		return NullNumeric{true, newUint(uint64(x), PrecisionBits, RoundNearest)}
 	case uint8:
-		return NullNumeric{true, newUint(uint64(x))}
+		return NullNumeric{true, newUint(uint64(x), PrecisionBits, RoundNearest)}
 	case uint16:
-		return NullNumeric{true, newUint(uint64(x))}
+		return NullNumeric{true, newUint(uint64(x), PrecisionBits, RoundNearest)}
 	case uint32:
-		return NullNumeric{true, newUint(uint64(x))}
+		return NullNumeric{true, newUint(uint64(x), PrecisionBits, RoundNearest)}
 	case uint64:
-		return NullNumeric{true, newUint(x)}
+		return NullNumeric{true, newUint(x, PrecisionBits, RoundNearest)}
 	case float32:
-		return NullNumeric{true, newFloat(float64(x))}
+		return NullNumeric{true, newFloat(float64(x), 32, PrecisionBits, RoundNearest)}
 	case float64:
-		return NullNumeric{true, newFloat(x)}
+		return NullNumeric{true, newFloat(x, 64, PrecisionBits, RoundNearest)}
 	case string:
-		return NullNumeric{true, newString(x)}
+		return NullNumeric{true, newString(x, PrecisionBits, RoundNearest)}
 	default:
 		panic(fmt.Sprintf("numeric: Invalid type. Type has to be int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64 or string. Got: %T", x))
 	}
@@ -77,13 +77,13 @@ func NewNullWithError(x any) (NullNumeric, error) {
 	case uint64:
 		return newNullUintWithError(x)
 	case float32:
-		return newNullFloatWithError(float64(x))
+		return newNullFloatWithError(float64(x), 32)
 	case float64:
-		return newNullFloatWithError(x)
+		return newNullFloatWithError(x, 64)
 	case string:
 		return newNullStringWithError(x)
 	default:
-		return NullNumeric{}, fmt.Errorf("numeric: Invalid type. Type has to be int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64 or string. Got: %T", x)
+		return NullNumeric{}, fmt.Errorf("%w. Type has to be int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64 or string. Got: %T", ErrInvalidType, x)
 	}
 }
 
@@ -92,7 +92,7 @@ func NewNullWithError(x any) (NullNumeric, error) {
 // region Private
 
 func newNullIntWithError(x int64) (NullNumeric, error) {
-	num, err := newIntWithError(x)
+	num, err := newIntWithError(x, PrecisionBits, RoundNearest)
 	if err != nil {
 		return NullNumeric{}, err
 	}
@@ -101,7 +101,7 @@ func newNullIntWithError(x int64) (NullNumeric, error) {
 }
 
 func newNullUintWithError(x uint64) (NullNumeric, error) {
-	num, err := newUintWithError(x)
+	num, err := newUintWithError(x, PrecisionBits, RoundNearest)
 	if err != nil {
 		return NullNumeric{}, err
 	}
@@ -109,8 +109,8 @@ func newNullUintWithError(x uint64) (NullNumeric, error) {
 	return NullNumeric{true, num}, nil
 }
 
-func newNullFloatWithError(x float64) (NullNumeric, error) {
-	num, err := newFloatWithError(x)
+func newNullFloatWithError(x float64, bitSize int) (NullNumeric, error) {
+	num, err := newFloatWithError(x, bitSize, PrecisionBits, RoundNearest)
 	if err != nil {
 		return NullNumeric{}, err
 	}
@@ -119,7 +119,7 @@ func newNullFloatWithError(x float64) (NullNumeric, error) {
 }
 
 func newNullStringWithError(x string) (NullNumeric, error) {
-	num, err := newStringWithError(x)
+	num, err := newStringWithError(x, PrecisionBits, RoundNearest)
 	if err != nil {
 		return NullNumeric{}, err
 	}
@@ -3,22 +3,56 @@ package numeric
 /*
 #cgo LDFLAGS: -lmpfr
 #include <mpfr.h>
+#include <stdlib.h>
 */
 import "C"
 import (
 	"errors"
 	"fmt"
+	"math"
 	"regexp"
+	"strconv"
+	"strings"
+	"unsafe"
 )
 
 // region Global Variables
 var (
 	PrecisionBits       uint64 = 53 // Default MPFR precision
 	StringDecimalPlaces uint64 = 10 // Default decimal places for string conversion
+	FloatConversionMode        = FloatShortest
+	MarshalStringMode          = MarshalFixed
 )
 
+// Matches a numeric literal: an optional sign, digits with an optional fraction (leading digits may be omitted),
+// and an optional exponent. Digit groups may be separated by a single underscore, e.g. "1_000_000".
+var numericStringRegex = regexp.MustCompile(`^[+-]?(\d+(_\d+)*(\.(\d+(_\d+)*)?)?|\.\d+(_\d+)*)([eE][+-]?\d+(_\d+)*)?$`)
+
 // endregion
 
+// Determines how float32 and float64 values are converted into numeric values.
+type FloatConversion int
+
+const (
+	// Uses the shortest decimal string that converts back to the same float, e.g. 0.1 becomes 0.1.
+	FloatShortest FloatConversion = iota
+	// Uses the exact binary value of the float, e.g. 0.1 becomes 0.1000000000000000055511151231257827021181583404541015625.
+	// The precision of the numeric value is raised to fit the float if needed, so no digit is ever lost.
+	FloatExact
+)
+
+// Determines how numeric values are written by MarshalJSON, Value and MarshalBinary.
+type MarshalMode int
+
+const (
+	// Writes the number with the default number of decimal places, like String does.
+	MarshalFixed MarshalMode = iota
+	// Writes the number with the default number of decimal places, without trailing zeros, like StringTrimmed does.
+	MarshalTrimmed
+	// Writes the shortest string that converts back to the same number, like ShortestString does.
+	MarshalShortest
+)
+
 type Numeric struct {
 	init bool
 	val  C.mpfr_t
@@ -33,31 +67,31 @@ func New(x any) Numeric {
 	case Numeric:
 		return x
 	case int:
-		return newInt(int64(x))
+		return newInt(int64(x), PrecisionBits, RoundNearest)
 	case int8:
-		return newInt(int64(x))
+		return newInt(int64(x), PrecisionBits, RoundNearest)
 	case int16:
-		return newInt(int64(x))
+		return newInt(int64(x), PrecisionBits, RoundNearest)
 	case int32:
-		return newInt(int64(x))
+		return newInt(int64(x), PrecisionBits, RoundNearest)
 	case int64:
-		return newInt(x)
+		return newInt(x, PrecisionBits, RoundNearest)
 	case uint:
-		return newUint(uint64(x))
+		return newUint(uint64(x), PrecisionBits, RoundNearest)
 	case uint8:
-		return newUint(uint64(x))
+		return newUint(uint64(x), PrecisionBits, RoundNearest)
 	case uint16:
-		return newUint(uint64(x))
+		return newUint(uint64(x), PrecisionBits, RoundNearest)
 	case uint32:
-		return newUint(uint64(x))
+		return newUint(uint64(x), PrecisionBits, RoundNearest)
 	case uint64:
-		return newUint(x)
+		return newUint(x, PrecisionBits, RoundNearest)
 	case float32:
-		return newFloat(float64(x))
+		return newFloat(float64(x), 32, PrecisionBits, RoundNearest)
 	case float64:
-		return newFloat(x)
+		return newFloat(x, 64, PrecisionBits, RoundNearest)
 	case string:
-		return newString(x)
+		return newString(x, PrecisionBits, RoundNearest)
 	default:
 		panic(fmt.Sprintf("numeric: Invalid type. Type has to be int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64 or string. Got: %T", x))
 	}
@@ -70,45 +104,72 @@ func NewWithError(x any) (Numeric, error) {
 	case Numeric:
 		return x, nil
 	case int:
-		return newIntWithError(int64(x))
+		return newIntWithError(int64(x), PrecisionBits, RoundNearest)
 	case int8:
-		return newIntWithError(int64(x))
+		return newIntWithError(int64(x), PrecisionBits, RoundNearest)
 	case int16:
-		return newIntWithError(int64(x))
+		return newIntWithError(int64(x), PrecisionBits, RoundNearest)
 	case int32:
-		return newIntWithError(int64(x))
+		return newIntWithError(int64(x), PrecisionBits, RoundNearest)
 	case int64:
-		return newIntWithError(x)
+		return newIntWithError(x, PrecisionBits, RoundNearest)
 	case uint:
-		return newUintWithError(uint64(x))
+		return newUintWithError(uint64(x), PrecisionBits, RoundNearest)
 	case uint8:
-		return newUintWithError(uint64(x))
+		return newUintWithError(uint64(x), PrecisionBits, RoundNearest)
 	case uint16:
-		return newUintWithError(uint64(x))
+		return newUintWithError(uint64(x), PrecisionBits, RoundNearest)
 	case uint32:
-		return newUintWithError(uint64(x))
+		return newUintWithError(uint64(x), PrecisionBits, RoundNearest)
 	case uint64:
-		return newUintWithError(x)
+		return newUintWithError(x, PrecisionBits, RoundNearest)
 	case float32:
-		return newFloatWithError(float64(x))
+		return newFloatWithError(float64(x), 32, PrecisionBits, RoundNearest)
 	case float64:
-		return newFloatWithError(x)
+		return newFloatWithError(x, 64, PrecisionBits, RoundNearest)
 	case string:
-		return newStringWithError(x)
+		return newStringWithError(x, PrecisionBits, RoundNearest)
 	default:
-		return Numeric{}, fmt.Errorf("numeric: Invalid type. Type has to be int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64 or string. Got: %T", x)
+		return Numeric{}, fmt.Errorf("%w. Type has to be int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64 or string. Got: %T", ErrInvalidType, x)
+	}
+}
+
+// Creates a new numeric value with the given precision bits, instead of the default precision bits.
+// The type of x has to be int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, string or Numeric.
+func NewWithPrecision(x any, bits uint64) Numeric {
+	num, err := newWithPrecisionWithError(x, bits, RoundNearest)
+	if err != nil {
+		panic(err.Error())
 	}
+
+	return num
 }
 
-// Sets the default precision bits when doing arithmetic operations.
+// Sets the default precision bits of newly created numeric values.
+// Every numeric value carries its own precision, so values that already exist keep theirs.
+// Arithmetic results use the larger precision of both operands.
 // The upper limit is "virtually" unlimited. However, more precision bits will make your app use more RAM.
 // Cranking this number up to a large number will also make arithmetic operations slower. So bear this in mind.
 // Default value is 53.
 func SetPrecisionBits(bits uint64) {
-	C.mpfr_set_default_prec(C.long(bits))
 	PrecisionBits = bits
 }
 
+// Returns the precision bits of the number.
+func (n Numeric) Precision() uint64 {
+	if !n.init {
+		return PrecisionBits
+	}
+
+	return uint64(C.mpfr_get_prec(&n.val[0]))
+}
+
+// Changes the precision bits of the number, rounding its value to the nearest value representable with the new precision.
+// This will modify the original number.
+func (n *Numeric) SetPrecision(bits uint64) {
+	*n = newNumeric(*n, bits, RoundNearest)
+}
+
 // Sets the decimal places shown when .String() is called.
 // The upper limit is "virtually" unlimited. However, more decimal places will make your number inaccurate.
 // Example: 1.23 will be represented as 1.22999999... if you set a high decimal places, with not enough precision bits.
@@ -118,6 +179,19 @@ func SetStringDecimalPlaces(dp uint64) {
 	StringDecimalPlaces = dp
 }
 
+// Sets how float32 and float64 values are converted into numeric values.
+// FloatShortest keeps the digits you would see when printing the float, FloatExact keeps every bit of the binary value.
+// Default value is FloatShortest.
+func SetFloatConversion(mode FloatConversion) {
+	FloatConversionMode = mode
+}
+
+// Sets how numeric values are written by MarshalJSON, Value and MarshalBinary.
+// Default value is MarshalFixed.
+func SetMarshalMode(mode MarshalMode) {
+	MarshalStringMode = mode
+}
+
 // Clears the memory for the numeric value.
 func (n Numeric) Destroy() {
 	if n.init {
@@ -129,60 +203,186 @@ func (n Numeric) Destroy() {
 // endregion
 
 // region Private
-func newInt(x int64) Numeric {
-	return newString(fmt.Sprintf("%d", x))
+func newInt(x int64, bits uint64, rnd RoundingMode) Numeric {
+	return newString(fmt.Sprintf("%d", x), bits, rnd)
 }
 
-func newUint(x uint64) Numeric {
-	return newString(fmt.Sprintf("%d", x))
+func newUint(x uint64, bits uint64, rnd RoundingMode) Numeric {
+	return newString(fmt.Sprintf("%d", x), bits, rnd)
 }
 
-func newFloat(x float64) Numeric {
-	return newString(fmt.Sprintf("%f", x))
+func newFloat(x float64, bitSize int, bits uint64, rnd RoundingMode) Numeric {
+	num, err := newFloatWithError(x, bitSize, bits, rnd)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return num
 }
 
-func newString(x string) Numeric {
-	// Validate numeric string
-	if !regexp.MustCompile(`^\d+(\.\d+)?$`).MatchString(x) {
-		panic("numeric: Invalid string. String has to be numerical")
+func newString(x string, bits uint64, rnd RoundingMode) Numeric {
+	num, err := newStringWithError(x, bits, rnd)
+	if err != nil {
+		panic(err.Error())
 	}
 
-	num := Numeric{}
-	num.init = true
+	return num
+}
 
-	if ok := C.mpfr_init_set_str(&num.val[0], C.CString(x), C.int(10), C.MPFR_RNDN); ok != 0 {
-		panic("numeric: Failed to initialize mpfr_t")
+func newNumeric(x Numeric, bits uint64, rnd RoundingMode) Numeric {
+	num, err := newNumericWithError(x, bits, rnd)
+	if err != nil {
+		panic(err.Error())
 	}
 
 	return num
 }
 
-func newIntWithError(x int64) (Numeric, error) {
-	return newStringWithError(fmt.Sprintf("%d", x))
+func newWithPrecisionWithError(x any, bits uint64, rnd RoundingMode) (Numeric, error) {
+	switch x := x.(type) {
+	case Numeric:
+		return newNumericWithError(x, bits, rnd)
+	case int:
+		return newIntWithError(int64(x), bits, rnd)
+	case int8:
+		return newIntWithError(int64(x), bits, rnd)
+	case int16:
+		return newIntWithError(int64(x), bits, rnd)
+	case int32:
+		return newIntWithError(int64(x), bits, rnd)
+	case int64:
+		return newIntWithError(x, bits, rnd)
+	case uint:
+		return newUintWithError(uint64(x), bits, rnd)
+	case uint8:
+		return newUintWithError(uint64(x), bits, rnd)
+	case uint16:
+		return newUintWithError(uint64(x), bits, rnd)
+	case uint32:
+		return newUintWithError(uint64(x), bits, rnd)
+	case uint64:
+		return newUintWithError(x, bits, rnd)
+	case float32:
+		return newFloatWithError(float64(x), 32, bits, rnd)
+	case float64:
+		return newFloatWithError(x, 64, bits, rnd)
+	case string:
+		return newStringWithError(x, bits, rnd)
+	default:
+		return Numeric{}, fmt.Errorf("%w. Type has to be int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, string or Numeric. Got: %T", ErrInvalidType, x)
+	}
+}
+
+func newIntWithError(x int64, bits uint64, rnd RoundingMode) (Numeric, error) {
+	return newStringWithError(fmt.Sprintf("%d", x), bits, rnd)
 }
 
-func newUintWithError(x uint64) (Numeric, error) {
-	return newStringWithError(fmt.Sprintf("%d", x))
+func newUintWithError(x uint64, bits uint64, rnd RoundingMode) (Numeric, error) {
+	return newStringWithError(fmt.Sprintf("%d", x), bits, rnd)
 }
 
-func newFloatWithError(x float64) (Numeric, error) {
-	return newStringWithError(fmt.Sprintf("%f", x))
+func newFloatWithError(x float64, bitSize int, bits uint64, rnd RoundingMode) (Numeric, error) {
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return Numeric{}, errors.New("numeric: Invalid float. NaN and infinity are not supported")
+	}
+
+	if FloatConversionMode == FloatExact {
+		// A float64 has 53 bits of mantissa (24 for float32), so at least that many bits are needed to hold it exactly
+		mantissa := uint64(53)
+		if bitSize == 32 {
+			mantissa = 24
+		}
+
+		if bits < mantissa {
+			bits = mantissa
+		}
+
+		num, err := newEmptyWithError(bits)
+		if err != nil {
+			return Numeric{}, err
+		}
+
+		C.mpfr_set_d(&num.val[0], C.double(x), rnd.mpfr())
+
+		return num, nil
+	}
+
+	return newStringWithError(strconv.FormatFloat(x, 'g', -1, bitSize), bits, rnd)
 }
 
-func newStringWithError(x string) (Numeric, error) {
+func newStringWithError(x string, bits uint64, rnd RoundingMode) (Numeric, error) {
 	// Validate numeric string
-	if !regexp.MustCompile(`^\d+(\.\d+)?$`).MatchString(x) {
+	if !numericStringRegex.MatchString(x) {
 		return Numeric{}, errors.New("numeric: Invalid string. String has to be numerical")
 	}
 
-	num := Numeric{}
-	num.init = true
+	num, err := newEmptyWithError(bits)
+	if err != nil {
+		return Numeric{}, err
+	}
+
+	// Digit separators are only meaningful to humans, MPFR does not understand them
+	cstr := C.CString(strings.ReplaceAll(x, "_", ""))
+	defer C.free(unsafe.Pointer(cstr))
 
-	if ok := C.mpfr_init_set_str(&num.val[0], C.CString(x), C.int(10), C.MPFR_RNDN); ok != 0 {
+	C.mpfr_clear_flags()
+	if ok := C.mpfr_set_str(&num.val[0], cstr, C.int(10), rnd.mpfr()); ok != 0 {
 		return Numeric{}, errors.New("numeric: Failed to initialize mpfr_t")
 	}
 
+	// Exponents beyond the MPFR exponent range become infinity or zero, which would silently change the value
+	if C.mpfr_number_p(&num.val[0]) == 0 || C.mpfr_overflow_p() != 0 || C.mpfr_underflow_p() != 0 {
+		return Numeric{}, fmt.Errorf("%w. The exponent of the string is beyond the supported range", ErrOutOfRange)
+	}
+
+	return num, nil
+}
+
+func newNumericWithError(x Numeric, bits uint64, rnd RoundingMode) (Numeric, error) {
+	if !x.init {
+		x = New(0)
+	}
+
+	num, err := newEmptyWithError(bits)
+	if err != nil {
+		return Numeric{}, err
+	}
+
+	C.mpfr_set(&num.val[0], &x.val[0], rnd.mpfr())
+
+	return num, nil
+}
+
+// Allocates a numeric value with the given precision. Its value is NaN until it is set.
+func newEmpty(bits uint64) Numeric {
+	num, err := newEmptyWithError(bits)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return num
+}
+
+func newEmptyWithError(bits uint64) (Numeric, error) {
+	if bits < C.MPFR_PREC_MIN || bits > C.MPFR_PREC_MAX {
+		return Numeric{}, fmt.Errorf("numeric: Invalid precision. Precision bits have to be between %d and %d. Got: %d", C.MPFR_PREC_MIN, uint64(C.MPFR_PREC_MAX), bits)
+	}
+
+	num := Numeric{}
+	num.init = true
+
+	C.mpfr_init2(&num.val[0], C.mpfr_prec_t(bits))
+
 	return num, nil
 }
 
+// Returns the larger precision of the given numeric values, which is used as the precision of arithmetic results.
+func maxPrecision(a Numeric, b Numeric) uint64 {
+	if a.Precision() > b.Precision() {
+		return a.Precision()
+	}
+
+	return b.Precision()
+}
+
 // endregion
@@ -0,0 +1,89 @@
+package numeric
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestNewWithErrorString(t *testing.T) {
+	tests := []struct {
+		str  string
+		want string
+	}{
+		{"0", "0"},
+		{"5", "5"},
+		{"-5", "-5"},
+		{"+2", "2"},
+		{"1.25", "1.25"},
+		{"-0.25", "-0.25"},
+		{".5", "0.5"},
+		{"-.5", "-0.5"},
+		{"5.", "5"},
+		{"1e3", "1000"},
+		{"1E3", "1000"},
+		{"1e-9", "0.000000001"},
+		{"-2.5e+2", "-250"},
+		{"1_000_000", "1000000"},
+		{"1_000.000_1", "1000.0001"},
+		{"1e1_0", "10000000000"},
+		{"0e999999999999", "0"},
+	}
+
+	for _, tt := range tests {
+		n, err := NewWithError(tt.str)
+		if err != nil {
+			t.Errorf("NewWithError(%q) returned error: %v", tt.str, err)
+			continue
+		}
+
+		if got := n.ShortestString(); got != tt.want {
+			t.Errorf("NewWithError(%q) = %s, want %s", tt.str, got, tt.want)
+		}
+	}
+}
+
+func TestNewWithErrorInvalidString(t *testing.T) {
+	tests := []string{
+		"",
+		" 1",
+		"1 ",
+		"abc",
+		"--1",
+		"+-1",
+		"1.2.3",
+		".",
+		"-.",
+		"e5",
+		"1e",
+		"1e+",
+		"1_",
+		"_1",
+		"1__0",
+		"1._5",
+		"1_.5",
+		"0x10",
+		"inf",
+		"NaN",
+		"1,000",
+	}
+
+	for _, str := range tests {
+		if n, err := NewWithError(str); err == nil {
+			t.Errorf("NewWithError(%q) = %s, want error", str, n.ShortestString())
+		}
+	}
+}
+
+func TestNewWithErrorExponentRange(t *testing.T) {
+	for _, str := range []string{"1e999999999999", "-1e999999999999", "1e-999999999999"} {
+		if _, err := NewWithError(str); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("NewWithError(%q) error = %v, want ErrOutOfRange", str, err)
+		}
+	}
+
+	var v struct{ A Numeric }
+	if err := json.Unmarshal([]byte(`{"A":"1e999999999999"}`), &v); err == nil {
+		t.Errorf("json.Unmarshal of an out of range exponent returned no error")
+	}
+}
@@ -0,0 +1,368 @@
+package numeric
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// A parsed section of a number pattern, such as `"Rp" #,##0.00`.
+type patternSection struct {
+	prefix             string
+	suffix             string
+	minInteger         int    // Number of '0' (or increment) digits in the integer part
+	minFraction        int    // Number of '0' (or increment) digits in the fractional part
+	maxFraction        int    // Number of digits in the fractional part
+	groupSize          int    // Number of digits after the last ',' in the integer part, 0 if there is no grouping
+	secondaryGroupSize int    // Number of digits between the last two ',' in the integer part
+	increment          string // Rounding increment made of the pattern digits, e.g. "0.05" for "#,##0.05", empty if there is none
+	scientific         bool   // Whether the pattern has an exponent, e.g. "0.###E+0"
+	exponentSign       bool   // Whether positive exponents are written with a '+'
+	minExponent        int    // Number of '0' digits in the exponent
+	multiplier         int    // 2 for percent and 3 for per mille, the power of ten the number is multiplied with
+	hasNumber          bool   // Whether the section has a number part, sections without one are written as they are
+}
+
+// region Public
+
+// Returns numeric formatted with a number pattern, using a subset of the ICU DecimalFormat and Excel number format syntax.
+// Panics if the pattern is invalid. See FormatPatternWithError for the supported syntax.
+func (n Numeric) FormatPattern(pattern string) string {
+	str, err := n.FormatPatternWithError(pattern)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return str
+}
+
+// Returns numeric formatted with a number pattern, with error handling. The supported syntax is:
+//
+//   - Up to three sections separated by ';': the positive, the negative and the zero section, e.g. "#,##0.00;(#,##0.00);-".
+//     Without a negative section, negative numbers are formatted with the positive section and a leading '-'.
+//     The negative section formats the absolute value of the number, the zero section is used when the number is exactly zero.
+//     Negative numbers that round to zero are formatted as zero with the positive section.
+//     The negative and the zero section may be plain text, e.g. "-" in the example above.
+//   - '0' is a mandatory digit and '#' an optional digit, e.g. "#,##0.0#" formats 1234.5 as 1,234.5 and 1234.567 as 1,234.57.
+//     Fractional digits are rounded half to even.
+//   - ',' groups the integer digits with the number of digits after the last ',', and optionally a different number
+//     of digits between the last two ',', e.g. "#,##,##0" for Indian grouping.
+//   - Digits 1 to 9 set a rounding increment, e.g. "0.05" rounds to multiples of 0.05.
+//   - 'E' followed by an optional '+' and at least one '0' formats in scientific notation, e.g. "0.###E+0" formats 1234 as 1.234E+3.
+//     The number of '0' before the decimal point is the number of integer digits of the mantissa.
+//   - '%' multiplies the number by 100 and '‰' by 1000, and both are written as they are.
+//   - Text in single quotes (two single quotes for a quote), text in double quotes and characters escaped with a backslash are written as they are,
+//     as well as any other character that is not part of the number, e.g. `"Rp" #,##0` or "#,##0 'units'".
+func (n Numeric) FormatPatternWithError(pattern string) (string, error) {
+	if !n.init {
+		n = New(0)
+	}
+
+	sections, err := parsePattern(pattern)
+	if err != nil {
+		return "", err
+	}
+
+	if !n.LessThan(0) {
+		if len(sections) == 3 && n.Equal(0) {
+			return sections[2].format(n)
+		}
+
+		return sections[0].format(n)
+	}
+
+	// The digits of the negative section decide whether the number rounds to zero, or those of the positive section if it is plain text
+	section := sections[0]
+	if len(sections) >= 2 && sections[1].hasNumber {
+		section = sections[1]
+	}
+
+	abs := newNumeric(n, n.Precision(), RoundNearest).Abs()
+	str, err := section.format(abs)
+	if err != nil {
+		return "", err
+	}
+
+	// Do not show a sign or the negative section for numbers that were rounded to zero
+	if zero, _ := section.format(New(0)); str == zero {
+		return sections[0].format(New(0))
+	}
+
+	switch {
+	case len(sections) == 1:
+		return "-" + str, nil
+	case sections[1].hasNumber:
+		return str, nil
+	}
+
+	return sections[1].format(abs)
+}
+
+// endregion
+
+// region Private
+
+// Splits a pattern into sections, taking quotes and escapes into account.
+func parsePattern(pattern string) ([]patternSection, error) {
+	var sections []patternSection
+
+	section := patternSection{}
+	literal := strings.Builder{}
+	number := strings.Builder{}
+	inNumber, afterNumber := false, false
+
+	flushLiteral := func() {
+		if afterNumber {
+			section.suffix += literal.String()
+		} else {
+			section.prefix += literal.String()
+		}
+		literal.Reset()
+	}
+
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		switch {
+		case r == '\'' || r == '"':
+			// Quoted text, in ICU '' is a literal quote
+			if r == '\'' && i+1 < len(runes) && runes[i+1] == '\'' {
+				literal.WriteRune('\'')
+				i++
+				break
+			}
+
+			// Inside single quotes, '' is a literal quote as well, e.g. 'o''clock'
+			end := i + 1
+			for ; end < len(runes); end++ {
+				if runes[end] != r {
+					literal.WriteRune(runes[end])
+				} else if r == '\'' && end+1 < len(runes) && runes[end+1] == '\'' {
+					literal.WriteRune('\'')
+					end++
+				} else {
+					break
+				}
+			}
+			if end == len(runes) {
+				return nil, errors.New("numeric: Invalid pattern. Unterminated quote")
+			}
+
+			i = end
+
+		case r == '\\':
+			if i+1 == len(runes) {
+				return nil, errors.New("numeric: Invalid pattern. Pattern cannot end with a backslash")
+			}
+
+			literal.WriteRune(runes[i+1])
+			i++
+
+		case r == ';':
+			if inNumber {
+				if err := section.parseNumber(number.String()); err != nil {
+					return nil, err
+				}
+			}
+			flushLiteral()
+
+			sections = append(sections, section)
+			section, inNumber, afterNumber = patternSection{}, false, false
+			number.Reset()
+
+		case !afterNumber && strings.ContainsRune("#0123456789.,", r):
+			if !inNumber {
+				flushLiteral()
+				inNumber = true
+			}
+			number.WriteRune(r)
+
+			// The exponent belongs to the number: 'E', an optional sign and the exponent digits
+			if i+1 < len(runes) && runes[i+1] == 'E' && strings.ContainsRune("#0123456789.", r) {
+				number.WriteRune('E')
+				i++
+				for i+1 < len(runes) && strings.ContainsRune("+0", runes[i+1]) {
+					number.WriteRune(runes[i+1])
+					i++
+				}
+			}
+
+		default:
+			if inNumber {
+				if err := section.parseNumber(number.String()); err != nil {
+					return nil, err
+				}
+				inNumber, afterNumber = false, true
+			}
+
+			switch r {
+			case '%':
+				section.multiplier += 2
+			case '‰':
+				section.multiplier += 3
+			}
+
+			literal.WriteRune(r)
+		}
+	}
+
+	if inNumber {
+		if err := section.parseNumber(number.String()); err != nil {
+			return nil, err
+		}
+	}
+	flushLiteral()
+	sections = append(sections, section)
+
+	if len(sections) > 3 {
+		return nil, errors.New("numeric: Invalid pattern. Pattern has to have at most three sections")
+	}
+
+	if !sections[0].hasNumber {
+		return nil, errors.New("numeric: Invalid pattern. The first section has to have a number part, e.g. #,##0.00")
+	}
+
+	return sections, nil
+}
+
+// Parses the number part of a section, such as "#,##0.00" or "0.###E+0".
+func (s *patternSection) parseNumber(number string) error {
+	s.hasNumber = true
+
+	mantissa, exponent, scientific := strings.Cut(number, "E")
+	if scientific {
+		s.scientific = true
+		s.exponentSign = strings.HasPrefix(exponent, "+")
+		s.minExponent = strings.Count(exponent, "0")
+
+		if s.minExponent == 0 {
+			return errors.New("numeric: Invalid pattern. Exponent has to have at least one '0'")
+		}
+	}
+
+	integer, fraction, _ := strings.Cut(mantissa, ".")
+	if strings.ContainsAny(fraction, ".,") {
+		return errors.New("numeric: Invalid pattern. Fractional part cannot contain '.' or ','")
+	}
+
+	if !strings.ContainsAny(integer+fraction, "#0123456789") {
+		return errors.New("numeric: Invalid pattern. Number part has to have at least one digit")
+	}
+
+	// Grouping sizes are the lengths of the last two groups of the integer part
+	if groups := strings.Split(integer, ","); len(groups) > 1 {
+		s.groupSize = len(groups[len(groups)-1])
+		if len(groups) > 2 {
+			s.secondaryGroupSize = len(groups[len(groups)-2])
+		}
+
+		if s.groupSize == 0 {
+			return errors.New("numeric: Invalid pattern. Grouping separator cannot be the last character of the integer part")
+		}
+	}
+
+	integer = strings.ReplaceAll(integer, ",", "")
+	s.minInteger = len(strings.TrimLeft(integer, "#"))
+	s.minFraction = len(strings.TrimRight(fraction, "#"))
+	s.maxFraction = len(fraction)
+
+	if strings.ContainsAny(integer+fraction, "123456789") {
+		increment := strings.ReplaceAll(integer, "#", "0")
+		if fraction != "" {
+			increment += "." + strings.ReplaceAll(fraction, "#", "0")
+		}
+		s.increment = increment
+	}
+
+	return nil
+}
+
+// Formats a non-negative number with the section.
+func (s patternSection) format(n Numeric) (string, error) {
+	if !s.hasNumber {
+		return s.prefix, nil
+	}
+
+	if str, ok := n.nonFinite(); ok {
+		return s.prefix + str + s.suffix, nil
+	}
+
+	if s.multiplier > 0 {
+		n = exactMultiply(n, pow10(uint64(s.multiplier)))
+	}
+
+	if s.scientific {
+		return s.prefix + s.formatScientific(n) + s.suffix, nil
+	}
+
+	if s.increment != "" {
+		step, err := newStringWithError(s.increment, n.Precision(), RoundNearest)
+		if err != nil {
+			return "", err
+		}
+
+		if step.GreaterThan(0) {
+			n = n.RoundToIncrement(step, RoundHalfEven)
+		}
+	}
+
+	integer, fraction, _ := strings.Cut(n.str(uint64(s.maxFraction), RoundNearest), ".")
+
+	return s.prefix + s.formatDigits(integer, fraction) + s.suffix, nil
+}
+
+// Formats a non-negative number in scientific notation, with the number of integer digits of the pattern.
+func (s patternSection) formatScientific(n Numeric) string {
+	integerDigits := max(s.minInteger, 1)
+
+	_, digits, exp := n.significand(integerDigits + s.maxFraction)
+	exp -= integerDigits - 1
+
+	// Zero has no leading digit to align, so its exponent is always zero
+	if n.Equal(0) {
+		exp = 0
+	}
+
+	mantissa := s.formatDigits(digits[:integerDigits], digits[integerDigits:])
+
+	sign := ""
+	if exp < 0 {
+		sign, exp = "-", -exp
+	} else if s.exponentSign {
+		sign = "+"
+	}
+
+	expDigits := strconv.Itoa(exp)
+	if len(expDigits) < s.minExponent {
+		expDigits = strings.Repeat("0", s.minExponent-len(expDigits)) + expDigits
+	}
+
+	return mantissa + "E" + sign + expDigits
+}
+
+// Applies the minimum digits and the grouping of the section to the integer and fractional digits of a number.
+func (s patternSection) formatDigits(integer string, fraction string) string {
+	integer = strings.TrimLeft(integer, "0")
+	if len(integer) < s.minInteger {
+		integer = strings.Repeat("0", s.minInteger-len(integer)) + integer
+	}
+
+	for len(fraction) > s.minFraction && strings.HasSuffix(fraction, "0") {
+		fraction = fraction[:len(fraction)-1]
+	}
+
+	if integer == "" && fraction == "" {
+		integer = "0"
+	}
+
+	integer = Locale{GroupSize: s.groupSize, SecondaryGroupSize: s.secondaryGroupSize, GroupSeparator: ","}.group(integer)
+
+	if fraction == "" {
+		return integer
+	}
+
+	return integer + "." + fraction
+}
+
+// endregion
@@ -0,0 +1,120 @@
+package numeric
+
+import "testing"
+
+func TestFormatPattern(t *testing.T) {
+	tests := []struct {
+		value   string
+		pattern string
+		want    string
+	}{
+		// Digits and grouping
+		{"1234.5", "#,##0.00", "1,234.50"},
+		{"1234.567", "#,##0.0#", "1,234.57"},
+		{"1234.5", "#,##0.0#", "1,234.5"},
+		{"0.5", "#,##0", "0"},
+		{"0.5", "#.##", ".5"},
+		{"5", "000", "005"},
+		{"1234567", "#,##,##0", "12,34,567"},
+		{"1234567", "#,###", "1,234,567"},
+		{"123", "#,##0", "123"},
+
+		// Ties are rounded half to even
+		{"0.125", "0.00", "0.12"},
+		{"0.375", "0.00", "0.38"},
+
+		// Rounding increments
+		{"1.03", "0.05", "1.05"},
+		{"1.02", "0.05", "1.00"},
+		{"37.5", "#,##25", "50"},
+
+		// Sections
+		{"-1234.5", "#,##0.00;(#,##0.00)", "(1,234.50)"},
+		{"-1234.5", "#,##0.00", "-1,234.50"},
+		{"0", "#,##0.00;(#,##0.00);-", "-"},
+		{"-5", "#,##0;neg", "neg"},
+		{"-0.001", "#,##0.00;(#,##0.00)", "0.00"},
+		{"-0.001", "#,##0.00;(#,##0.00);-", "0.00"},
+		{"-0.001", "#,##0.00", "0.00"},
+		{"-0.001", "0.00;(0.000)", "(0.001)"},
+		{"-0.001", "0.000;-", "-"},
+		{"-0.0001", "0.000;-", "0.000"},
+
+		// Scientific notation
+		{"1234", "0.###E+0", "1.234E+3"},
+		{"0.00012", "0.##E0", "1.2E-4"},
+		{"1234", "00.##E0", "12.34E2"},
+		{"1234", "0.0E00", "1.2E03"},
+		{"0", "0.00E0", "0.00E0"},
+
+		// Percent and per mille
+		{"0.125", "0.0%", "12.5%"},
+		{"0.0125", "0.0‰", "12.5‰"},
+
+		// Literals
+		{"1234", `"Rp" #,##0`, "Rp 1,234"},
+		{"5", "#,##0 'units'", "5 units"},
+		{"5", "0 'o''clock'", "5 o'clock"},
+		{"5", "''0", "'5"},
+		{"5", "0 'it''s'", "5 it's"},
+		{"5", `\#0`, "#5"},
+		{"5", "0 'E' 0", "5 E 0"},
+	}
+
+	for _, tt := range tests {
+		got, err := New(tt.value).FormatPatternWithError(tt.pattern)
+		if err != nil {
+			t.Errorf("New(%q).FormatPatternWithError(%q) returned error: %v", tt.value, tt.pattern, err)
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf("New(%q).FormatPatternWithError(%q) = %s, want %s", tt.value, tt.pattern, got, tt.want)
+		}
+	}
+}
+
+func TestFormatPatternInfinity(t *testing.T) {
+	big := New("1e200000000")
+	inf := big.Multiply(big)
+
+	tests := []struct {
+		value   Numeric
+		pattern string
+		want    string
+	}{
+		{inf, "#,##0.00", "inf"},
+		{inf.Neg(), "#,##0.00", "-inf"},
+		{inf.Neg(), "#,##0.00;(#,##0.00)", "(inf)"},
+		{inf, "0.###E+0", "inf"},
+		{inf, "0.0%", "inf%"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.value.FormatPattern(tt.pattern); got != tt.want {
+			t.Errorf("%s.FormatPattern(%q) = %s, want %s", tt.value.String(), tt.pattern, got, tt.want)
+		}
+	}
+}
+
+func TestFormatPatternInvalid(t *testing.T) {
+	tests := []string{
+		"",
+		"text",
+		"'0",
+		`0\`,
+		"0;0;0;0",
+		"0.0.0",
+		"0.0,0",
+		"#,##0,",
+		"0E",
+		"0E+",
+		"-;0",
+	}
+
+	for _, pattern := range tests {
+		if str, err := New(1).FormatPatternWithError(pattern); err == nil {
+			t.Errorf("New(1).FormatPatternWithError(%q) = %s, want error", pattern, str)
+		}
+	}
+}
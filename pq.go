@@ -30,7 +30,7 @@ func (n *Numeric) Scan(value any) error {
 
 // Value implements the driver.Valuer interface.
 func (n Numeric) Value() (driver.Value, error) {
-	return n.String(), nil
+	return n.marshalString(), nil
 }
 
 // Array form of Numeric, used for scanning and storing arrays of Numeric in postgresql.
@@ -85,10 +85,10 @@ func (a NumericArray) Value() (driver.Value, error) {
 		b := make([]byte, 1, 1+3*n)
 		b[0] = '{'
 
-		b = appendArrayQuotedBytes(b, []byte(a[0].String()))
+		b = appendArrayQuotedBytes(b, []byte(a[0].marshalString()))
 		for i := 1; i < n; i++ {
 			b = append(b, ',')
-			b = appendArrayQuotedBytes(b, []byte(a[i].String()))
+			b = appendArrayQuotedBytes(b, []byte(a[i].marshalString()))
 		}
 
 		return string(append(b, '}')), nil
@@ -133,7 +133,7 @@ func (n NullNumeric) Value() (driver.Value, error) {
 		return nil, nil
 	}
 
-	return n.Numeric.String(), nil
+	return n.Numeric.marshalString(), nil
 }
 
 // endregion
@@ -2,7 +2,7 @@ package numeric
 
 // Implements go-redis encoding interface.
 func (n Numeric) MarshalBinary() ([]byte, error) {
-	return []byte(n.String()), nil
+	return []byte(n.marshalString()), nil
 }
 
 // Implements go-redis decoding interface.
@@ -27,7 +27,7 @@ func (n NullNumeric) MarshalBinary() ([]byte, error) {
 		return []byte("null"), nil
 	}
 
-	return []byte(n.Numeric.String()), nil
+	return []byte(n.Numeric.marshalString()), nil
 }
 
 // Implements go-redis decoding interface.
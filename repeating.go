@@ -0,0 +1,125 @@
+package numeric
+
+/*
+#cgo LDFLAGS: -lmpfr
+#include <mpfr.h>
+*/
+import "C"
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// region Global Variables
+
+// Matches a decimal with an optional repeating part in parentheses, e.g. "0.1(6)", "-2.(142857)" or "12.5".
+var repeatingStringRegex = regexp.MustCompile(`^([+-]?)(\d*)(?:\.(\d*)(?:\((\d+)\))?)?$`)
+
+// endregion
+
+// region Public
+
+// Parses a decimal with a repeating part in parentheses into a numeric value, e.g. "0.1(6)" is 1/6 and "0.(142857)" is 1/7.
+// The value is computed as an exact fraction first, so it is only rounded once to PrecisionBits.
+// Decimals without a repeating part, e.g. "12.5", are parsed as they are.
+func ParseRepeating(s string) (Numeric, error) {
+	match := repeatingStringRegex.FindStringSubmatch(strings.TrimSpace(s))
+	if match == nil || match[2]+match[3]+match[4] == "" {
+		return Numeric{}, errors.New("numeric: Invalid string. String has to be a decimal with an optional repeating part in parentheses, e.g. 0.1(6)")
+	}
+
+	sign, integer, fixed, repeating := match[1], match[2], match[3], match[4]
+
+	// x = 0.F(R) is (FR - F) / ((10^r - 1) * 10^f), where FR and F are the digits read as integers
+	num := integerString(integer + fixed + repeating)
+	den := pow10(uint64(len(fixed)))
+
+	if repeating != "" {
+		num = exactIntegerSubtract(num, integerString(integer+fixed))
+		den = exactMultiply(den, exactIntegerSubtract(pow10(uint64(len(repeating))), New(1)))
+	}
+
+	if sign == "-" && !num.Equal(0) {
+		num = num.Neg()
+	}
+
+	return num.divide(den, PrecisionBits, RoundNearest), nil
+}
+
+// Returns the fraction numerator/denominator as a decimal with its repeating part in parentheses, e.g. 1/7 is 0.(142857) and 1/6 is 0.1(6).
+// Fractions that terminate are written without parentheses, e.g. 1/4 is 0.25.
+// At most `maxDigits` digits are written after the decimal point. If the repeating part is not found within them, "..." is appended.
+// The numerator and the denominator have to be integers. Pass Numeric values for integers beyond uint64.
+func FormatRepeating(numerator any, denominator any, maxDigits int) string {
+	num, den := integerOperand(numerator), integerOperand(denominator)
+	if !num.init {
+		num = New(0)
+	}
+
+	if !den.init || den.Equal(0) {
+		panic("numeric: Division by zero")
+	}
+
+	if C.mpfr_integer_p(&num.val[0]) == 0 || C.mpfr_integer_p(&den.val[0]) == 0 {
+		panic("numeric: Numerator and denominator have to be integers")
+	}
+
+	if maxDigits < 0 {
+		panic("numeric: Number of digits cannot be negative")
+	}
+
+	sign := ""
+	if num.LessThan(0) != den.LessThan(0) && !num.Equal(0) {
+		sign = "-"
+	}
+	num, den = num.Abs(), den.Abs()
+
+	integer := floorDivide(num, den)
+	remainder := exactIntegerSubtract(num, exactMultiply(integer, den))
+
+	// Long division: the digits repeat as soon as a remainder repeats
+	digits := ""
+	seen := map[string]int{}
+	ten := New(10)
+
+	for !remainder.Equal(0) {
+		key := remainder.str(0, RoundNearest)
+		if start, ok := seen[key]; ok {
+			return sign + integer.str(0, RoundNearest) + "." + digits[:start] + "(" + digits[start:] + ")"
+		}
+
+		if len(digits) == maxDigits {
+			return sign + integer.str(0, RoundNearest) + strings.TrimSuffix("."+digits, ".") + "..."
+		}
+		seen[key] = len(digits)
+
+		remainder = exactMultiply(remainder, ten)
+		digit := floorDivide(remainder, den)
+		remainder = exactIntegerSubtract(remainder, exactMultiply(digit, den))
+
+		digits += digit.str(0, RoundNearest)
+	}
+
+	if digits == "" {
+		return sign + integer.str(0, RoundNearest)
+	}
+
+	return sign + integer.str(0, RoundNearest) + "." + digits
+}
+
+// endregion
+
+// region Private
+
+// Creates a new numeric value from a string of decimal digits, with enough precision to hold the integer exactly.
+func integerString(digits string) Numeric {
+	digits = strings.TrimLeft(digits, "0")
+	if digits == "" {
+		return New(0)
+	}
+
+	return newString(digits, uint64(float64(len(digits))*3.321928094887362)+2, RoundNearest)
+}
+
+// endregion
@@ -0,0 +1,147 @@
+package numeric
+
+/*
+#cgo LDFLAGS: -lmpfr
+#include <mpfr.h>
+*/
+import "C"
+import (
+	"errors"
+	"fmt"
+)
+
+// region Public
+
+// Returns the square root of the number, correctly rounded to the precision of the number.
+// Panics if the number is negative. This will not modify the original number.
+func (n Numeric) Sqrt() Numeric {
+	return must(n.SqrtE())
+}
+
+// Returns the square root of the number, with an error if the number is negative. This will not modify the original number.
+func (n Numeric) SqrtE() (Numeric, error) {
+	if !n.init {
+		n = New(0)
+	}
+
+	if n.LessThan(0) {
+		return Numeric{}, n.notReal("square root")
+	}
+
+	result := newEmpty(n.Precision())
+	C.mpfr_sqrt(&result.val[0], &n.val[0], C.MPFR_RNDN)
+
+	return result, nil
+}
+
+// Returns the cube root of the number, correctly rounded to the precision of the number.
+// Negative numbers have a negative cube root, e.g. the cube root of -8 is -2. This will not modify the original number.
+func (n Numeric) Cbrt() Numeric {
+	if !n.init {
+		n = New(0)
+	}
+
+	result := newEmpty(n.Precision())
+	C.mpfr_cbrt(&result.val[0], &n.val[0], C.MPFR_RNDN)
+
+	return result
+}
+
+// Returns the k-th root of the number, correctly rounded to the precision of the number. `k` has to be greater than zero.
+// Negative numbers only have odd roots, e.g. the 5th root of -32 is -2. Panics if the root is not a real number.
+// This will not modify the original number.
+func (n Numeric) RootN(k int) Numeric {
+	return must(n.RootNE(k))
+}
+
+// Returns the k-th root of the number, with an error if `k` is not greater than zero,
+// or if the number is negative and `k` is even. This will not modify the original number.
+func (n Numeric) RootNE(k int) (Numeric, error) {
+	if !n.init {
+		n = New(0)
+	}
+
+	if k < 1 {
+		return Numeric{}, errors.New("numeric: Root degree has to be greater than zero")
+	}
+
+	if n.LessThan(0) && k%2 == 0 {
+		return Numeric{}, n.notReal(fmt.Sprintf("root of degree %d", k))
+	}
+
+	result := newEmpty(n.Precision())
+	C.mpfr_rootn_ui(&result.val[0], &n.val[0], C.ulong(k), C.MPFR_RNDN)
+
+	return result, nil
+}
+
+// Returns the reciprocal of the square root of the number, 1/sqrt(n), correctly rounded to the precision of the number.
+// Panics if the number is zero or negative. This will not modify the original number.
+func (n Numeric) InvSqrt() Numeric {
+	return must(n.InvSqrtE())
+}
+
+// Returns the reciprocal of the square root of the number, with an error if the number is zero or negative.
+// This will not modify the original number.
+func (n Numeric) InvSqrtE() (Numeric, error) {
+	if !n.init {
+		n = New(0)
+	}
+
+	if n.Equal(0) {
+		return Numeric{}, ErrDivisionByZero
+	}
+
+	if n.LessThan(0) {
+		return Numeric{}, n.notReal("square root")
+	}
+
+	result := newEmpty(n.Precision())
+	C.mpfr_rec_sqrt(&result.val[0], &n.val[0], C.MPFR_RNDN)
+
+	return result, nil
+}
+
+// Returns sqrt(n^2 + x^2), the length of the hypotenuse of a right triangle, without overflow or underflow in the intermediate squares.
+// The result is correctly rounded to the larger precision of both numbers. Panics if `x` has an unsupported type.
+// This will not modify the original number.
+func (n Numeric) Hypot(x any) Numeric {
+	return must(n.HypotE(x))
+}
+
+// Returns sqrt(n^2 + x^2), with an error if `x` has an unsupported type. This will not modify the original number.
+func (n Numeric) HypotE(x any) (Numeric, error) {
+	if !n.init {
+		n = New(0)
+	}
+
+	_x, err := n.operand(x)
+	if err != nil {
+		return Numeric{}, err
+	}
+
+	result := newEmpty(maxPrecision(n, _x))
+	C.mpfr_hypot(&result.val[0], &n.val[0], &_x.val[0], C.MPFR_RNDN)
+
+	return result, nil
+}
+
+// endregion
+
+// region Private
+
+// Returns an error for an operation on the number whose result is not a real number.
+func (n Numeric) notReal(operation string) error {
+	return fmt.Errorf("%w. The %s of %s is complex", ErrNotReal, operation, n.errorString())
+}
+
+// Returns the result of a checked operation, panicking on error.
+func must(result Numeric, err error) Numeric {
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return result
+}
+
+// endregion
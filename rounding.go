@@ -6,48 +6,249 @@ package numeric
 */
 import "C"
 
+// Rounding modes used by arithmetic operations, conversions and rounding to decimal places.
+// MPFR has no equivalent for the half modes other than RoundHalfEven, so arithmetic operations and conversions to floats
+// treat them as RoundNearest. Conversions to integers and decimal strings apply them like Round does.
+type RoundingMode int
+
+const (
+	RoundNearest          RoundingMode = iota // Round to the nearest value, ties to even (MPFR_RNDN)
+	RoundTowardZero                           // Round toward zero (MPFR_RNDZ)
+	RoundUp                                   // Round toward positive infinity (MPFR_RNDU)
+	RoundDown                                 // Round toward negative infinity (MPFR_RNDD)
+	RoundAwayFromZero                         // Round away from zero (MPFR_RNDA)
+	RoundHalfUp                               // Round to the nearest value, ties toward positive infinity
+	RoundHalfDown                             // Round to the nearest value, ties toward negative infinity
+	RoundHalfAwayFromZero                     // Round to the nearest value, ties away from zero
+	RoundHalfTowardZero                       // Round to the nearest value, ties toward zero
+
+	RoundHalfEven = RoundNearest // Round to the nearest value, ties to even (banker's rounding)
+)
+
 // region Public
 
-// Ceil the number to the specified decimal places.
-func (n Numeric) Ceil(dp int) Numeric {
+// Round the number to the specified decimal places using the given rounding mode.
+// A negative number of decimal places rounds to tens, hundreds, and so on.
+// The rounding decision is made on the exact value of the number, so the result is only rounded once,
+// to the nearest value representable with the precision of the number. This will not modify the original number.
+func (n Numeric) Round(dp int, mode RoundingMode) Numeric {
 	if !n.init {
 		n = New(0)
 	}
 
-	scale := New(10).Pow(dp)
-	C.mpfr_mul_ui(&n.val[0], &n.val[0], C.ulong(scale.Uint()), C.MPFR_RNDN)
-	C.mpfr_ceil(&n.val[0], &n.val[0])
-	C.mpfr_div_ui(&n.val[0], &n.val[0], C.ulong(scale.Uint()), C.MPFR_RNDN)
+	return n.roundToMultiple(New(1), dp, mode)
+}
+
+// Round the number to a multiple of `step` using the given rounding mode, e.g. to 0.05 for cash rounding or to 25 for tick sizes.
+// The step is taken as the shortest decimal that converts back to it, so 0.05 means exactly 0.05 rather than its binary approximation.
+// The step has to be greater than zero. This will not modify the original number.
+func (n Numeric) RoundToIncrement(step Numeric, mode RoundingMode) Numeric {
+	if !n.init {
+		n = New(0)
+	}
 
-	return n
+	if !step.init || !step.GreaterThan(0) {
+		panic("numeric: Increment has to be greater than zero")
+	}
+
+	// Split the step into an integer unit and a number of decimal places: 0.05 is 5 with 2 decimal places, 2500 is 25 with -2
+	digits, exp := step.shortestDecimal()
+	unit := NewWithPrecision(digits, uint64(float64(len(digits))*3.321928094887362)+2)
+
+	return n.roundToMultiple(unit, -exp, mode)
 }
 
-// Floor the number to the specified decimal places.
-func (n Numeric) Floor(dp int) Numeric {
+// Ceil the number to a multiple of `step`. See RoundToIncrement.
+func (n Numeric) CeilToIncrement(step Numeric) Numeric {
+	return n.RoundToIncrement(step, RoundUp)
+}
+
+// Floor the number to a multiple of `step`. See RoundToIncrement.
+func (n Numeric) FloorToIncrement(step Numeric) Numeric {
+	return n.RoundToIncrement(step, RoundDown)
+}
+
+// Round the number to the specified number of significant digits using the given rounding mode.
+// Example: 123456 rounded to 3 significant digits is 123000, and 0.000123456 is 0.000123. This will not modify the original number.
+func (n Numeric) RoundSignificant(sig int, mode RoundingMode) Numeric {
 	if !n.init {
 		n = New(0)
 	}
 
-	scale := New(10).Pow(dp)
-	C.mpfr_mul_ui(&n.val[0], &n.val[0], C.ulong(scale.Uint()), C.MPFR_RNDN)
-	C.mpfr_floor(&n.val[0], &n.val[0])
-	C.mpfr_div_ui(&n.val[0], &n.val[0], C.ulong(scale.Uint()), C.MPFR_RNDN)
+	if sig < 1 {
+		panic("numeric: Significant digits have to be greater than zero")
+	}
+
+	return n.Round(sig-1-n.decimalExponent(), mode)
+}
+
+// Ceil the number to the specified decimal places.
+// A negative number of decimal places rounds up to tens, hundreds, and so on. This will not modify the original number.
+func (n Numeric) Ceil(dp int) Numeric {
+	return n.Round(dp, RoundUp)
+}
 
-	return n
+// Floor the number to the specified decimal places.
+// A negative number of decimal places rounds down to tens, hundreds, and so on. This will not modify the original number.
+func (n Numeric) Floor(dp int) Numeric {
+	return n.Round(dp, RoundDown)
 }
 
 // Truncate the number to the specified decimal places.
+// A negative number of decimal places truncates to tens, hundreds, and so on. This will not modify the original number.
 func (n Numeric) Truncate(dp int) Numeric {
-	if !n.init {
-		n = New(0)
+	return n.Round(dp, RoundTowardZero)
+}
+
+// endregion
+
+// region Private
+
+// Returns the MPFR equivalent of the rounding mode.
+func (m RoundingMode) mpfr() C.mpfr_rnd_t {
+	switch m {
+	case RoundTowardZero:
+		return C.MPFR_RNDZ
+	case RoundUp:
+		return C.MPFR_RNDU
+	case RoundDown:
+		return C.MPFR_RNDD
+	case RoundAwayFromZero:
+		return C.MPFR_RNDA
+	default:
+		return C.MPFR_RNDN
+	}
+}
+
+// Reports whether the rounding mode is one of the half modes that MPFR does not have.
+func (m RoundingMode) isHalf() bool {
+	return m == RoundHalfUp || m == RoundHalfDown || m == RoundHalfAwayFromZero || m == RoundHalfTowardZero
+}
+
+// Rounds the number to a multiple of `unit` * 10^-scale, where `unit` is a positive integer.
+func (n Numeric) roundToMultiple(unit Numeric, scale int, mode RoundingMode) Numeric {
+	bits := n.Precision()
+
+	// Zero, infinity and NaN are multiples of anything, and MPFR has no exponent for them
+	if C.mpfr_regular_p(&n.val[0]) == 0 {
+		return newNumeric(n, bits, RoundNearest)
+	}
+
+	// A number with m significant bits whose most significant bit has the weight 2^(e-1) is a multiple of 2^(e-m),
+	// so it has at most m-e decimal places. Numbers that already fit are returned as is.
+	if scale >= 0 && unit.Equal(1) && int64(scale) >= int64(C.mpfr_min_prec(&n.val[0]))-int64(C.mpfr_get_exp(&n.val[0])) {
+		return newNumeric(n, bits, RoundNearest)
+	}
+
+	if scale > 0 {
+		// Scale the number up instead of scaling the step down, as 10^-scale has no exact binary representation
+		factor := pow10(uint64(scale))
+		scaled := exactMultiply(n, factor)
+		rounded := scaled.roundToStep(unit, mode)
+
+		return rounded.divide(factor, bits, RoundNearest)
+	}
+
+	step := exactMultiply(unit, pow10(uint64(-scale)))
+	rounded := n.roundToStep(step, mode)
+
+	return newNumeric(rounded, bits, RoundNearest)
+}
+
+// Rounds the number to a multiple of `step`, which has to be positive. The result is exact, so its precision may grow.
+func (n Numeric) roundToStep(step Numeric, mode RoundingMode) Numeric {
+	if C.mpfr_regular_p(&n.val[0]) == 0 {
+		return n
+	}
+
+	// The remainder, the multiples of step and the differences between them are all multiples of the smaller
+	// weight of the least significant bits of n and step, so these many bits are enough to compute them exactly.
+	nExp := int64(C.mpfr_get_exp(&n.val[0]))
+	stepExp := int64(C.mpfr_get_exp(&step.val[0]))
+	lsb := min(nExp-int64(n.Precision()), stepExp-int64(step.Precision()))
+	top := max(nExp, stepExp) + 2
+
+	// Remainder of the division toward zero, it has the sign of n
+	remainder := newEmpty(uint64(stepExp + 1 - lsb))
+	C.mpfr_fmod(&remainder.val[0], &n.val[0], &step.val[0], C.MPFR_RNDN)
+
+	if C.mpfr_zero_p(&remainder.val[0]) != 0 {
+		return n
+	}
+
+	// The multiple of step toward zero and the one away from zero
+	towardZero := newEmpty(uint64(top - lsb))
+	C.mpfr_sub(&towardZero.val[0], &n.val[0], &remainder.val[0], C.MPFR_RNDN)
+
+	awayFromZero := newEmpty(uint64(top - lsb))
+	if C.mpfr_sgn(&n.val[0]) > 0 {
+		C.mpfr_add(&awayFromZero.val[0], &towardZero.val[0], &step.val[0], C.MPFR_RNDN)
+	} else {
+		C.mpfr_sub(&awayFromZero.val[0], &towardZero.val[0], &step.val[0], C.MPFR_RNDN)
+	}
+
+	positive := C.mpfr_sgn(&n.val[0]) > 0
+
+	switch mode {
+	case RoundTowardZero:
+		return towardZero
+	case RoundAwayFromZero:
+		return awayFromZero
+	case RoundUp:
+		return pick(positive, awayFromZero, towardZero)
+	case RoundDown:
+		return pick(!positive, awayFromZero, towardZero)
+	}
+
+	// Compare the distance to the multiple toward zero with half a step
+	C.mpfr_mul_2ui(&remainder.val[0], &remainder.val[0], 1, C.MPFR_RNDN)
+	if cmp := C.mpfr_cmpabs(&remainder.val[0], &step.val[0]); cmp != 0 {
+		return pick(cmp > 0, awayFromZero, towardZero)
+	}
+
+	switch mode {
+	case RoundHalfUp:
+		return pick(positive, awayFromZero, towardZero)
+	case RoundHalfDown:
+		return pick(!positive, awayFromZero, towardZero)
+	case RoundHalfAwayFromZero:
+		return awayFromZero
+	case RoundHalfTowardZero:
+		return towardZero
 	}
 
-	scale := New(10).Pow(dp)
-	C.mpfr_mul_ui(&n.val[0], &n.val[0], C.ulong(scale.Uint()), C.MPFR_RNDN)
-	C.mpfr_trunc(&n.val[0], &n.val[0])
-	C.mpfr_div_ui(&n.val[0], &n.val[0], C.ulong(scale.Uint()), C.MPFR_RNDN)
+	// Ties to even: the quotient toward zero is odd if the remainder of the division by two steps is at least one step
+	doubleStep := newEmpty(step.Precision())
+	C.mpfr_mul_2ui(&doubleStep.val[0], &step.val[0], 1, C.MPFR_RNDN)
+
+	doubleRemainder := newEmpty(uint64(stepExp + 2 - lsb))
+	C.mpfr_fmod(&doubleRemainder.val[0], &n.val[0], &doubleStep.val[0], C.MPFR_RNDN)
+
+	odd := C.mpfr_cmpabs(&doubleRemainder.val[0], &step.val[0]) >= 0
+
+	return pick(odd, awayFromZero, towardZero)
+}
+
+// Returns 10^exp exactly.
+func pow10(exp uint64) Numeric {
+	// 10^exp = 5^exp * 2^exp, and 5^exp has at most floor(exp * log2(5)) + 1 significant bits
+	result := newEmpty(uint64(float64(exp)*2.321928094887362) + 2)
+	C.mpfr_ui_pow_ui(&result.val[0], 10, C.ulong(exp), C.MPFR_RNDN)
+
+	return result
+}
+
+// Returns a * b exactly, using as many precision bits as needed.
+func exactMultiply(a Numeric, b Numeric) Numeric {
+	return a.multiply(b, a.Precision()+b.Precision(), RoundNearest)
+}
+
+func pick(cond bool, a Numeric, b Numeric) Numeric {
+	if cond {
+		return a
+	}
 
-	return n
+	return b
 }
 
 // endregion
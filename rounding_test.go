@@ -0,0 +1,269 @@
+package numeric
+
+import (
+	"fmt"
+	"testing"
+)
+
+var roundingModeNames = map[RoundingMode]string{
+	RoundNearest:          "RoundNearest",
+	RoundTowardZero:       "RoundTowardZero",
+	RoundUp:               "RoundUp",
+	RoundDown:             "RoundDown",
+	RoundAwayFromZero:     "RoundAwayFromZero",
+	RoundHalfUp:           "RoundHalfUp",
+	RoundHalfDown:         "RoundHalfDown",
+	RoundHalfAwayFromZero: "RoundHalfAwayFromZero",
+	RoundHalfTowardZero:   "RoundHalfTowardZero",
+}
+
+func TestRoundTies(t *testing.T) {
+	values := []string{"2.5", "-2.5", "3.5", "-3.5"}
+
+	tests := []struct {
+		mode RoundingMode
+		want []string
+	}{
+		{RoundNearest, []string{"2", "-2", "4", "-4"}},
+		{RoundTowardZero, []string{"2", "-2", "3", "-3"}},
+		{RoundUp, []string{"3", "-2", "4", "-3"}},
+		{RoundDown, []string{"2", "-3", "3", "-4"}},
+		{RoundAwayFromZero, []string{"3", "-3", "4", "-4"}},
+		{RoundHalfUp, []string{"3", "-2", "4", "-3"}},
+		{RoundHalfDown, []string{"2", "-3", "3", "-4"}},
+		{RoundHalfAwayFromZero, []string{"3", "-3", "4", "-4"}},
+		{RoundHalfTowardZero, []string{"2", "-2", "3", "-3"}},
+	}
+
+	for _, tt := range tests {
+		for i, value := range values {
+			got := New(value).Round(0, tt.mode).ShortestString()
+			if got != tt.want[i] {
+				t.Errorf("New(%q).Round(0, %s) = %s, want %s", value, roundingModeNames[tt.mode], got, tt.want[i])
+			}
+		}
+	}
+}
+
+func TestRoundNearTies(t *testing.T) {
+	halfModes := []RoundingMode{RoundNearest, RoundHalfUp, RoundHalfDown, RoundHalfAwayFromZero, RoundHalfTowardZero}
+
+	tests := []struct {
+		value string
+		dp    int
+		want  string
+	}{
+		{"2.5000001", 0, "3"},
+		{"2.4999999", 0, "2"},
+		{"-2.5000001", 0, "-3"},
+		{"-2.4999999", 0, "-2"},
+		{"0.12500001", 2, "0.13"},
+		{"0.12499999", 2, "0.12"},
+		{"-0.12500001", 2, "-0.13"},
+		{"-0.12499999", 2, "-0.12"},
+		// 1.005 is 1.00499999999999989... in binary, so it is below the tie
+		{"1.005", 2, "1"},
+		{"-1.005", 2, "-1"},
+	}
+
+	for _, tt := range tests {
+		for _, mode := range halfModes {
+			got := New(tt.value).Round(tt.dp, mode).ShortestString()
+			if got != tt.want {
+				t.Errorf("New(%q).Round(%d, %s) = %s, want %s", tt.value, tt.dp, roundingModeNames[mode], got, tt.want)
+			}
+		}
+	}
+}
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		value string
+		dp    int
+		mode  RoundingMode
+		want  string
+	}{
+		// Ties with decimal places
+		{"0.125", 2, RoundNearest, "0.12"},
+		{"0.125", 2, RoundHalfUp, "0.13"},
+		{"0.125", 2, RoundHalfDown, "0.12"},
+		{"0.125", 2, RoundHalfAwayFromZero, "0.13"},
+		{"0.125", 2, RoundHalfTowardZero, "0.12"},
+		{"-0.125", 2, RoundNearest, "-0.12"},
+		{"-0.125", 2, RoundHalfUp, "-0.12"},
+		{"-0.125", 2, RoundHalfDown, "-0.13"},
+		{"-0.125", 2, RoundHalfAwayFromZero, "-0.13"},
+		{"-0.125", 2, RoundHalfTowardZero, "-0.12"},
+		{"0.375", 2, RoundNearest, "0.38"},
+		{"0.0009765625", 9, RoundNearest, "0.000976562"},
+		{"0.0009765625", 9, RoundHalfUp, "0.000976563"},
+
+		// Directed modes
+		{"1.21", 1, RoundUp, "1.3"},
+		{"-1.21", 1, RoundUp, "-1.2"},
+		{"1.29", 1, RoundDown, "1.2"},
+		{"-1.21", 1, RoundDown, "-1.3"},
+		{"-1.29", 1, RoundTowardZero, "-1.2"},
+		{"-1.21", 1, RoundAwayFromZero, "-1.3"},
+		{"1.25", 2, RoundUp, "1.25"},
+		{"-1.25", 2, RoundDown, "-1.25"},
+		// 1.1 is 1.10000000000000008... in binary, so it is above 1.1
+		{"1.1", 1, RoundUp, "1.2"},
+		{"1.1", 1, RoundDown, "1.1"},
+
+		// Negative decimal places
+		{"1250", -2, RoundNearest, "1200"},
+		{"1350", -2, RoundNearest, "1400"},
+		{"1250", -2, RoundHalfUp, "1300"},
+		{"-1250", -2, RoundHalfUp, "-1200"},
+		{"-1250", -2, RoundHalfDown, "-1300"},
+		{"1251", -2, RoundNearest, "1300"},
+		{"149", -2, RoundUp, "200"},
+		{"-149", -2, RoundDown, "-200"},
+		{"-149", -2, RoundTowardZero, "-100"},
+		{"999", -3, RoundHalfUp, "1000"},
+		{"12345", -5, RoundNearest, "0"},
+		{"12345", -20, RoundUp, "100000000000000000000"},
+		{"-12345", -20, RoundDown, "-100000000000000000000"},
+
+		// Large decimal places
+		{"0.1", 20, RoundNearest, "0.1"},
+		{"0.3333333333333333", 30, RoundNearest, "0.3333333333333333"},
+		{"2.5", 1000, RoundUp, "2.5"},
+		{"-2.5", 1000, RoundDown, "-2.5"},
+
+		// Zero
+		{"0", 2, RoundUp, "0"},
+		{"0", -2, RoundDown, "0"},
+	}
+
+	for _, tt := range tests {
+		got := New(tt.value).Round(tt.dp, tt.mode).ShortestString()
+		if got != tt.want {
+			t.Errorf("New(%q).Round(%d, %s) = %s, want %s", tt.value, tt.dp, roundingModeNames[tt.mode], got, tt.want)
+		}
+	}
+}
+
+func TestRoundToIncrement(t *testing.T) {
+	tests := []struct {
+		value string
+		step  string
+		mode  RoundingMode
+		want  string
+	}{
+		// 0.125 is halfway between 0.10 and 0.15, 0.375 between 0.35 and 0.40
+		{"0.125", "0.05", RoundNearest, "0.1"},
+		{"0.125", "0.05", RoundHalfUp, "0.15"},
+		{"0.125", "0.05", RoundHalfDown, "0.1"},
+		{"0.125", "0.05", RoundHalfAwayFromZero, "0.15"},
+		{"0.125", "0.05", RoundHalfTowardZero, "0.1"},
+		{"-0.125", "0.05", RoundHalfUp, "-0.1"},
+		{"-0.125", "0.05", RoundHalfDown, "-0.15"},
+		{"0.375", "0.05", RoundNearest, "0.4"},
+		{"1.02", "0.05", RoundNearest, "1"},
+		{"1.03", "0.05", RoundNearest, "1.05"},
+		{"1.01", "0.05", RoundUp, "1.05"},
+		{"-1.01", "0.05", RoundUp, "-1"},
+		{"-1.01", "0.05", RoundDown, "-1.05"},
+		{"-1.04", "0.05", RoundTowardZero, "-1"},
+		{"-1.01", "0.05", RoundAwayFromZero, "-1.05"},
+		{"1.25", "0.05", RoundUp, "1.25"},
+		// 1.05 is 1.05000000000000004... in binary, so it is above 1.05
+		{"1.05", "0.05", RoundUp, "1.1"},
+		{"1.05", "0.05", RoundDown, "1.05"},
+
+		// 37.5 is halfway between 25 and 50, 62.5 between 50 and 75
+		{"37.5", "25", RoundNearest, "50"},
+		{"62.5", "25", RoundNearest, "50"},
+		{"37.5", "25", RoundHalfUp, "50"},
+		{"37.5", "25", RoundHalfDown, "25"},
+		{"37.5", "25", RoundHalfTowardZero, "25"},
+		{"-37.5", "25", RoundHalfUp, "-25"},
+		{"-37.5", "25", RoundHalfAwayFromZero, "-50"},
+		{"37.6", "25", RoundHalfDown, "50"},
+		{"37.4", "25", RoundHalfUp, "25"},
+		{"12", "25", RoundUp, "25"},
+		{"-12", "25", RoundUp, "0"},
+		{"-12", "25", RoundDown, "-25"},
+		{"100", "25", RoundAwayFromZero, "100"},
+	}
+
+	for _, tt := range tests {
+		got := New(tt.value).RoundToIncrement(New(tt.step), tt.mode).ShortestString()
+		if got != tt.want {
+			t.Errorf("New(%q).RoundToIncrement(%s, %s) = %s, want %s", tt.value, tt.step, roundingModeNames[tt.mode], got, tt.want)
+		}
+	}
+}
+
+func TestRoundInfinity(t *testing.T) {
+	big := New("1e200000000")
+	inf := big.Multiply(big)
+
+	for mode := range roundingModeNames {
+		for _, dp := range []int{-3, 0, 2} {
+			t.Run(fmt.Sprintf("%s/%d", roundingModeNames[mode], dp), func(t *testing.T) {
+				if got := inf.Round(dp, mode).String(); got != "inf" {
+					t.Errorf("inf.Round(%d) = %s, want inf", dp, got)
+				}
+
+				if got := inf.Neg().Round(dp, mode).String(); got != "-inf" {
+					t.Errorf("-inf.Round(%d) = %s, want -inf", dp, got)
+				}
+			})
+		}
+	}
+
+	if got := inf.RoundToIncrement(New("0.05"), RoundNearest).String(); got != "inf" {
+		t.Errorf("inf.RoundToIncrement(0.05) = %s, want inf", got)
+	}
+}
+
+func TestHalfModeConversions(t *testing.T) {
+	tests := []struct {
+		value   string
+		mode    RoundingMode
+		integer int64
+		str     string
+	}{
+		{"2.5", RoundNearest, 2, "2"},
+		{"2.5", RoundHalfUp, 3, "3"},
+		{"-2.5", RoundHalfUp, -2, "-2"},
+		{"2.5", RoundHalfDown, 2, "2"},
+		{"-2.5", RoundHalfDown, -3, "-3"},
+		{"2.5", RoundHalfAwayFromZero, 3, "3"},
+		{"-2.5", RoundHalfAwayFromZero, -3, "-3"},
+		{"2.5", RoundHalfTowardZero, 2, "2"},
+		{"-2.5", RoundHalfTowardZero, -2, "-2"},
+	}
+
+	for _, tt := range tests {
+		n := New(tt.value)
+
+		if got := n.Int64Rounded(tt.mode); got != tt.integer {
+			t.Errorf("New(%q).Int64Rounded(%s) = %d, want %d", tt.value, roundingModeNames[tt.mode], got, tt.integer)
+		}
+
+		if got, _ := n.Int64RoundedE(tt.mode); got != tt.integer {
+			t.Errorf("New(%q).Int64RoundedE(%s) = %d, want %d", tt.value, roundingModeNames[tt.mode], got, tt.integer)
+		}
+
+		if got := n.StringDecimalPlacesRounded(0, tt.mode); got != tt.str {
+			t.Errorf("New(%q).StringDecimalPlacesRounded(0, %s) = %s, want %s", tt.value, roundingModeNames[tt.mode], got, tt.str)
+		}
+
+		c := Context{Rounding: tt.mode}
+		if got := c.Format(n); got != tt.str {
+			t.Errorf("Context{Rounding: %s}.Format(%q) = %s, want %s", roundingModeNames[tt.mode], tt.value, got, tt.str)
+		}
+	}
+
+	if got := New("2.5").Uint64Rounded(RoundHalfUp); got != 3 {
+		t.Errorf("New(\"2.5\").Uint64Rounded(RoundHalfUp) = %d, want 3", got)
+	}
+
+	if got := New("0.125").StringDecimalPlacesRounded(2, RoundHalfUp); got != "0.13" {
+		t.Errorf("New(\"0.125\").StringDecimalPlacesRounded(2, RoundHalfUp) = %s, want 0.13", got)
+	}
+}
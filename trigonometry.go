@@ -0,0 +1,504 @@
+package numeric
+
+/*
+#cgo LDFLAGS: -lmpfr
+#include <mpfr.h>
+
+// mpfr_sinu and its siblings were added in MPFR 4.2.0. With older versions these return 0,
+// and the functions in degrees are computed in Go instead.
+enum { _SINU, _COSU, _TANU, _ASINU, _ACOSU, _ATANU };
+
+static int _unit(int f, mpfr_ptr result, mpfr_srcptr x) {
+#if MPFR_VERSION >= MPFR_VERSION_NUM(4,2,0)
+	switch (f) {
+	case _SINU: mpfr_sinu(result, x, 360, MPFR_RNDN); break;
+	case _COSU: mpfr_cosu(result, x, 360, MPFR_RNDN); break;
+	case _TANU: mpfr_tanu(result, x, 360, MPFR_RNDN); break;
+	case _ASINU: mpfr_asinu(result, x, 360, MPFR_RNDN); break;
+	case _ACOSU: mpfr_acosu(result, x, 360, MPFR_RNDN); break;
+	case _ATANU: mpfr_atanu(result, x, 360, MPFR_RNDN); break;
+	}
+	return 1;
+#else
+	return 0;
+#endif
+}
+
+static int _atan2u(mpfr_ptr result, mpfr_srcptr y, mpfr_srcptr x) {
+#if MPFR_VERSION >= MPFR_VERSION_NUM(4,2,0)
+	mpfr_atan2u(result, y, x, 360, MPFR_RNDN);
+	return 1;
+#else
+	return 0;
+#endif
+}
+*/
+import "C"
+
+// region Public
+
+// Returns the sine of the number in radians, correctly rounded to the precision of the number. This will not modify the original number.
+func (n Numeric) Sin() Numeric {
+	return must(n.unary(func(result, x *C.__mpfr_struct) { C.mpfr_sin(result, x, C.MPFR_RNDN) }))
+}
+
+// Returns the cosine of the number in radians, correctly rounded to the precision of the number. This will not modify the original number.
+func (n Numeric) Cos() Numeric {
+	return must(n.unary(func(result, x *C.__mpfr_struct) { C.mpfr_cos(result, x, C.MPFR_RNDN) }))
+}
+
+// Returns the tangent of the number in radians, correctly rounded to the precision of the number. This will not modify the original number.
+func (n Numeric) Tan() Numeric {
+	return must(n.unary(func(result, x *C.__mpfr_struct) { C.mpfr_tan(result, x, C.MPFR_RNDN) }))
+}
+
+// Returns the secant of the number in radians, 1/cos(n), correctly rounded to the precision of the number.
+// This will not modify the original number.
+func (n Numeric) Sec() Numeric {
+	return must(n.unary(func(result, x *C.__mpfr_struct) { C.mpfr_sec(result, x, C.MPFR_RNDN) }))
+}
+
+// Returns the cosecant of the number in radians, 1/sin(n), correctly rounded to the precision of the number.
+// Panics if the number is zero. This will not modify the original number.
+func (n Numeric) Csc() Numeric {
+	return must(n.CscE())
+}
+
+// Returns the cosecant of the number in radians, with an error if the number is zero. This will not modify the original number.
+func (n Numeric) CscE() (Numeric, error) {
+	if !n.init || n.Equal(0) {
+		return Numeric{}, ErrDivisionByZero
+	}
+
+	return n.unary(func(result, x *C.__mpfr_struct) { C.mpfr_csc(result, x, C.MPFR_RNDN) })
+}
+
+// Returns the cotangent of the number in radians, 1/tan(n), correctly rounded to the precision of the number.
+// Panics if the number is zero. This will not modify the original number.
+func (n Numeric) Cot() Numeric {
+	return must(n.CotE())
+}
+
+// Returns the cotangent of the number in radians, with an error if the number is zero. This will not modify the original number.
+func (n Numeric) CotE() (Numeric, error) {
+	if !n.init || n.Equal(0) {
+		return Numeric{}, ErrDivisionByZero
+	}
+
+	return n.unary(func(result, x *C.__mpfr_struct) { C.mpfr_cot(result, x, C.MPFR_RNDN) })
+}
+
+// Returns the sine and the cosine of the number in radians, both correctly rounded to the precision of the number.
+// This is faster than calling Sin and Cos. This will not modify the original number.
+func (n Numeric) SinCos() (Numeric, Numeric) {
+	if !n.init {
+		n = New(0)
+	}
+
+	sin, cos := newEmpty(n.Precision()), newEmpty(n.Precision())
+	C.mpfr_sin_cos(&sin.val[0], &cos.val[0], &n.val[0], C.MPFR_RNDN)
+
+	return sin, cos
+}
+
+// Returns the arcsine of the number in radians, in the range [-pi/2, pi/2], correctly rounded to the precision of the number.
+// Panics if the number is not between -1 and 1. This will not modify the original number.
+func (n Numeric) Asin() Numeric {
+	return must(n.AsinE())
+}
+
+// Returns the arcsine of the number in radians, with an error if the number is not between -1 and 1. This will not modify the original number.
+func (n Numeric) AsinE() (Numeric, error) {
+	if err := n.checkUnitInterval("arcsine"); err != nil {
+		return Numeric{}, err
+	}
+
+	return n.unary(func(result, x *C.__mpfr_struct) { C.mpfr_asin(result, x, C.MPFR_RNDN) })
+}
+
+// Returns the arccosine of the number in radians, in the range [0, pi], correctly rounded to the precision of the number.
+// Panics if the number is not between -1 and 1. This will not modify the original number.
+func (n Numeric) Acos() Numeric {
+	return must(n.AcosE())
+}
+
+// Returns the arccosine of the number in radians, with an error if the number is not between -1 and 1. This will not modify the original number.
+func (n Numeric) AcosE() (Numeric, error) {
+	if err := n.checkUnitInterval("arccosine"); err != nil {
+		return Numeric{}, err
+	}
+
+	return n.unary(func(result, x *C.__mpfr_struct) { C.mpfr_acos(result, x, C.MPFR_RNDN) })
+}
+
+// Returns the arctangent of the number in radians, in the range [-pi/2, pi/2], correctly rounded to the precision of the number.
+// This will not modify the original number.
+func (n Numeric) Atan() Numeric {
+	return must(n.unary(func(result, x *C.__mpfr_struct) { C.mpfr_atan(result, x, C.MPFR_RNDN) }))
+}
+
+// Returns the angle in radians of the point (x, n), in the range [-pi, pi], where the number is the y coordinate.
+// The result is correctly rounded to the larger precision of both numbers. Panics if `x` has an unsupported type.
+// This will not modify the original number.
+func (n Numeric) Atan2(x any) Numeric {
+	return must(n.Atan2E(x))
+}
+
+// Returns the angle in radians of the point (x, n), with an error if `x` has an unsupported type. This will not modify the original number.
+func (n Numeric) Atan2E(x any) (Numeric, error) {
+	if !n.init {
+		n = New(0)
+	}
+
+	_x, err := n.operand(x)
+	if err != nil {
+		return Numeric{}, err
+	}
+
+	result := newEmpty(maxPrecision(n, _x))
+	C.mpfr_atan2(&result.val[0], &n.val[0], &_x.val[0], C.MPFR_RNDN)
+
+	return result, nil
+}
+
+// Returns the sine of the number in degrees, rounded to the precision of the number.
+// The angle is not converted to radians first, so e.g. the sine of 30 degrees is exactly 0.5. The functions in degrees are correctly
+// rounded with MPFR 4.2.0 or later. Older versions reduce the angle exactly and compute the result with 64 extra bits.
+// This will not modify the original number.
+func (n Numeric) SinDeg() Numeric {
+	return must(n.unary(inDegrees(C._SINU, sinDeg)))
+}
+
+// Returns the cosine of the number in degrees, rounded to the precision of the number.
+// The angle is not converted to radians first, so e.g. the cosine of 90 degrees is exactly 0. This will not modify the original number.
+func (n Numeric) CosDeg() Numeric {
+	return must(n.unary(inDegrees(C._COSU, cosDeg)))
+}
+
+// Returns the tangent of the number in degrees, rounded to the precision of the number.
+// The angle is not converted to radians first, so e.g. the tangent of 45 degrees is exactly 1.
+// Panics if the number is an odd multiple of 90 degrees, where the tangent is infinite. This will not modify the original number.
+func (n Numeric) TanDeg() Numeric {
+	return must(n.TanDegE())
+}
+
+// Returns the tangent of the number in degrees, with an error if the number is an odd multiple of 90 degrees.
+// This will not modify the original number.
+func (n Numeric) TanDegE() (Numeric, error) {
+	return n.unary(inDegrees(C._TANU, tanDeg))
+}
+
+// Returns the arcsine of the number in degrees, in the range [-90, 90], rounded to the precision of the number.
+// Panics if the number is not between -1 and 1. This will not modify the original number.
+func (n Numeric) AsinDeg() Numeric {
+	return must(n.AsinDegE())
+}
+
+// Returns the arcsine of the number in degrees, with an error if the number is not between -1 and 1. This will not modify the original number.
+func (n Numeric) AsinDegE() (Numeric, error) {
+	if err := n.checkUnitInterval("arcsine"); err != nil {
+		return Numeric{}, err
+	}
+
+	return n.unary(inDegrees(C._ASINU, asinDeg))
+}
+
+// Returns the arccosine of the number in degrees, in the range [0, 180], rounded to the precision of the number.
+// Panics if the number is not between -1 and 1. This will not modify the original number.
+func (n Numeric) AcosDeg() Numeric {
+	return must(n.AcosDegE())
+}
+
+// Returns the arccosine of the number in degrees, with an error if the number is not between -1 and 1. This will not modify the original number.
+func (n Numeric) AcosDegE() (Numeric, error) {
+	if err := n.checkUnitInterval("arccosine"); err != nil {
+		return Numeric{}, err
+	}
+
+	return n.unary(inDegrees(C._ACOSU, acosDeg))
+}
+
+// Returns the arctangent of the number in degrees, in the range [-90, 90], rounded to the precision of the number.
+// This will not modify the original number.
+func (n Numeric) AtanDeg() Numeric {
+	return must(n.unary(inDegrees(C._ATANU, atanDeg)))
+}
+
+// Returns the angle in degrees of the point (x, n), in the range [-180, 180], where the number is the y coordinate.
+// The result is rounded to the larger precision of both numbers. Panics if `x` has an unsupported type.
+// This will not modify the original number.
+func (n Numeric) Atan2Deg(x any) Numeric {
+	return must(n.Atan2DegE(x))
+}
+
+// Returns the angle in degrees of the point (x, n), with an error if `x` has an unsupported type. This will not modify the original number.
+func (n Numeric) Atan2DegE(x any) (Numeric, error) {
+	if !n.init {
+		n = New(0)
+	}
+
+	_x, err := n.operand(x)
+	if err != nil {
+		return Numeric{}, err
+	}
+
+	result := newEmpty(maxPrecision(n, _x))
+	if C._atan2u(&result.val[0], &n.val[0], &_x.val[0]) == 0 {
+		result = atan2Deg(n, _x, result.Precision())
+	}
+
+	return result, nil
+}
+
+// endregion
+
+// region Private
+
+// Checks that the number is between -1 and 1, the domain of the arcsine and the arccosine.
+func (n Numeric) checkUnitInterval(operation string) error {
+	if !n.init {
+		return nil
+	}
+
+	if n.LessThan(-1) || n.GreaterThan(1) {
+		return n.notReal(operation)
+	}
+
+	return nil
+}
+
+// Returns a function in degrees for unary, which uses the MPFR function `f` when it is available, and `fallback` otherwise.
+func inDegrees(f C.int, fallback func(x Numeric, bits uint64) Numeric) func(result *C.__mpfr_struct, x *C.__mpfr_struct) {
+	return func(result *C.__mpfr_struct, x *C.__mpfr_struct) {
+		if C._unit(f, result, x) != 0 {
+			return
+		}
+
+		n := newEmpty(uint64(C.mpfr_get_prec(x)))
+		C.mpfr_set(&n.val[0], x, C.MPFR_RNDN)
+
+		value := fallback(n, uint64(C.mpfr_get_prec(result)))
+		C.mpfr_set(result, &value.val[0], C.MPFR_RNDN)
+	}
+}
+
+// Extra precision bits of the computations in degrees without mpfr_sinu and its siblings.
+const degreeGuardBits = 64
+
+// Returns the sine of `x` degrees rounded to `bits`, or NaN if `x` is not a number.
+func sinDeg(x Numeric, bits uint64) Numeric {
+	if C.mpfr_number_p(&x.val[0]) == 0 {
+		return newEmpty(bits)
+	}
+
+	// sin(-a) = -sin(a), sin(a) = -sin(a - 180) and sin(a) = sin(180 - a)
+	a, negative := reduceDegrees(x)
+	if a.GreaterThanOrEqual(180) {
+		a, negative = a.subtract(New(180), a.Precision(), RoundNearest), !negative
+	}
+	if a.GreaterThan(90) {
+		a = New(180).subtract(a, a.Precision(), RoundNearest)
+	}
+
+	result := sinReduced(a, bits)
+	if negative {
+		result = result.Neg()
+	}
+
+	return result
+}
+
+// Returns the cosine of `x` degrees rounded to `bits`, or NaN if `x` is not a number.
+func cosDeg(x Numeric, bits uint64) Numeric {
+	if C.mpfr_number_p(&x.val[0]) == 0 {
+		return newEmpty(bits)
+	}
+
+	// cos(-a) = cos(a), cos(a) = cos(360 - a) and cos(a) = -cos(180 - a)
+	a, _ := reduceDegrees(x)
+	negative := false
+	if a.GreaterThanOrEqual(180) {
+		a = New(360).subtract(a, a.Precision(), RoundNearest)
+	}
+	if a.GreaterThan(90) {
+		a, negative = New(180).subtract(a, a.Precision(), RoundNearest), true
+	}
+
+	result := sinReduced(New(90).subtract(a, a.Precision(), RoundNearest), bits)
+	if negative {
+		result = result.Neg()
+	}
+
+	return result
+}
+
+// Returns the tangent of `x` degrees rounded to `bits`, infinity at odd multiples of 90 degrees, or NaN if `x` is not a number.
+func tanDeg(x Numeric, bits uint64) Numeric {
+	if C.mpfr_number_p(&x.val[0]) == 0 {
+		return newEmpty(bits)
+	}
+
+	// tan(-a) = -tan(a), tan(a) = tan(a - 180) and tan(a) = -tan(180 - a)
+	a, negative := reduceDegrees(x)
+	if a.GreaterThanOrEqual(180) {
+		a = a.subtract(New(180), a.Precision(), RoundNearest)
+	}
+	if a.GreaterThan(90) {
+		a, negative = New(180).subtract(a, a.Precision(), RoundNearest), !negative
+	}
+
+	var result Numeric
+	if a.Equal(45) {
+		result = NewWithPrecision(1, bits)
+	} else {
+		sin := sinReduced(a, bits+degreeGuardBits)
+		cos := sinReduced(New(90).subtract(a, a.Precision(), RoundNearest), bits+degreeGuardBits)
+		result = sin.divide(cos, bits, RoundNearest)
+	}
+
+	if negative {
+		result = result.Neg()
+	}
+
+	return result
+}
+
+// Returns the arcsine of `x` in degrees rounded to `bits`, or NaN if `x` is not between -1 and 1.
+func asinDeg(x Numeric, bits uint64) Numeric {
+	for _, exact := range [][2]float64{{0, 0}, {0.5, 30}, {1, 90}, {-0.5, -30}, {-1, -90}} {
+		if x.Equal(exact[0]) {
+			return NewWithPrecision(exact[1], bits)
+		}
+	}
+
+	radians := newEmpty(bits + degreeGuardBits)
+	C.mpfr_asin(&radians.val[0], &x.val[0], C.MPFR_RNDN)
+
+	return toDegrees(radians, bits)
+}
+
+// Returns the arccosine of `x` in degrees rounded to `bits`, or NaN if `x` is not between -1 and 1.
+func acosDeg(x Numeric, bits uint64) Numeric {
+	for _, exact := range [][2]float64{{1, 0}, {0.5, 60}, {0, 90}, {-0.5, 120}, {-1, 180}} {
+		if x.Equal(exact[0]) {
+			return NewWithPrecision(exact[1], bits)
+		}
+	}
+
+	radians := newEmpty(bits + degreeGuardBits)
+	C.mpfr_acos(&radians.val[0], &x.val[0], C.MPFR_RNDN)
+
+	return toDegrees(radians, bits)
+}
+
+// Returns the arctangent of `x` in degrees rounded to `bits`, or NaN if `x` is NaN.
+func atanDeg(x Numeric, bits uint64) Numeric {
+	for _, exact := range [][2]float64{{0, 0}, {1, 45}, {-1, -45}} {
+		if x.Equal(exact[0]) {
+			return NewWithPrecision(exact[1], bits)
+		}
+	}
+
+	if C.mpfr_inf_p(&x.val[0]) != 0 {
+		result := NewWithPrecision(90, bits)
+		if x.LessThan(0) {
+			result = result.Neg()
+		}
+
+		return result
+	}
+
+	radians := newEmpty(bits + degreeGuardBits)
+	C.mpfr_atan(&radians.val[0], &x.val[0], C.MPFR_RNDN)
+
+	return toDegrees(radians, bits)
+}
+
+// Returns the angle of the point (x, y) in degrees rounded to `bits`, or NaN if `x` or `y` is NaN.
+func atan2Deg(y Numeric, x Numeric, bits uint64) Numeric {
+	if C.mpfr_nan_p(&y.val[0]) != 0 || C.mpfr_nan_p(&x.val[0]) != 0 {
+		return newEmpty(bits)
+	}
+
+	// The angles on the axes and on the diagonals are exact, the sign of the angle is the sign of y
+	var result Numeric
+	switch {
+	case y.Equal(0) || (C.mpfr_inf_p(&x.val[0]) != 0 && C.mpfr_inf_p(&y.val[0]) == 0):
+		result = NewWithPrecision(0, bits)
+		if C.mpfr_signbit(&x.val[0]) != 0 {
+			result = NewWithPrecision(180, bits)
+		}
+	case x.Equal(0) || (C.mpfr_inf_p(&y.val[0]) != 0 && C.mpfr_inf_p(&x.val[0]) == 0):
+		result = NewWithPrecision(90, bits)
+	case C.mpfr_cmpabs(&y.val[0], &x.val[0]) == 0:
+		result = NewWithPrecision(45, bits)
+		if x.LessThan(0) {
+			result = NewWithPrecision(135, bits)
+		}
+	default:
+		radians := newEmpty(bits + degreeGuardBits)
+		C.mpfr_atan2(&radians.val[0], &y.val[0], &x.val[0], C.MPFR_RNDN)
+
+		return toDegrees(radians, bits)
+	}
+
+	if C.mpfr_signbit(&y.val[0]) != 0 {
+		result = result.Neg()
+	}
+
+	return result
+}
+
+// Returns the absolute value of an angle in degrees reduced to [0, 360), and whether the angle is negative.
+// The reduction is exact, as the remainder needs at most 9 more bits than the angle.
+func reduceDegrees(x Numeric) (Numeric, bool) {
+	a := newEmpty(x.Precision() + 9)
+	C.mpfr_abs(&a.val[0], &x.val[0], C.MPFR_RNDN)
+
+	if a.GreaterThanOrEqual(360) {
+		full := New(360)
+		C.mpfr_fmod(&a.val[0], &a.val[0], &full.val[0], C.MPFR_RNDN)
+	}
+
+	return a, x.LessThan(0)
+}
+
+// Returns the sine of an angle between 0 and 90 degrees rounded to `bits`. The sines of 0, 30 and 90 degrees are exact.
+func sinReduced(a Numeric, bits uint64) Numeric {
+	for _, exact := range [][2]float64{{0, 0}, {30, 0.5}, {90, 1}} {
+		if a.Equal(exact[0]) {
+			return NewWithPrecision(exact[1], bits)
+		}
+	}
+
+	// The cosine of the complement is more accurate near 90 degrees
+	w := bits + degreeGuardBits
+	result := newEmpty(w)
+	if a.LessThanOrEqual(45) {
+		radians := toRadians(a, w)
+		C.mpfr_sin(&result.val[0], &radians.val[0], C.MPFR_RNDN)
+	} else {
+		radians := toRadians(New(90).subtract(a, a.Precision(), RoundNearest), w)
+		C.mpfr_cos(&result.val[0], &radians.val[0], C.MPFR_RNDN)
+	}
+
+	return newNumeric(result, bits, RoundNearest)
+}
+
+// Converts an angle in degrees to radians, rounded to `bits`.
+func toRadians(a Numeric, bits uint64) Numeric {
+	pi := newEmpty(bits)
+	C.mpfr_const_pi(&pi.val[0], C.MPFR_RNDN)
+
+	return a.multiply(pi, bits, RoundNearest).divide(New(180), bits, RoundNearest)
+}
+
+// Converts an angle in radians, computed with degreeGuardBits extra bits, to degrees rounded to `bits`.
+func toDegrees(radians Numeric, bits uint64) Numeric {
+	pi := newEmpty(radians.Precision())
+	C.mpfr_const_pi(&pi.val[0], C.MPFR_RNDN)
+
+	return radians.multiply(New(180), radians.Precision(), RoundNearest).divide(pi, bits, RoundNearest)
+}
+
+// endregion
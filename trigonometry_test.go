@@ -0,0 +1,128 @@
+package numeric
+
+import "testing"
+
+func TestDegreesExact(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(x Numeric) Numeric
+		x    string
+		want string
+	}{
+		{"SinDeg", Numeric.SinDeg, "30", "0.5"},
+		{"SinDeg", Numeric.SinDeg, "-150", "-0.5"},
+		{"SinDeg", Numeric.SinDeg, "180", "0"},
+		{"SinDeg", Numeric.SinDeg, "-270", "1"},
+		{"SinDeg", Numeric.SinDeg, "7230", "0.5"},
+		{"CosDeg", Numeric.CosDeg, "60", "0.5"},
+		{"CosDeg", Numeric.CosDeg, "90", "0"},
+		{"CosDeg", Numeric.CosDeg, "120", "-0.5"},
+		{"CosDeg", Numeric.CosDeg, "-720", "1"},
+		{"TanDeg", Numeric.TanDeg, "45", "1"},
+		{"TanDeg", Numeric.TanDeg, "135", "-1"},
+		{"TanDeg", Numeric.TanDeg, "-225", "-1"},
+		{"TanDeg", Numeric.TanDeg, "180", "0"},
+		{"AsinDeg", Numeric.AsinDeg, "0.5", "30"},
+		{"AsinDeg", Numeric.AsinDeg, "-1", "-90"},
+		{"AcosDeg", Numeric.AcosDeg, "-0.5", "120"},
+		{"AcosDeg", Numeric.AcosDeg, "0", "90"},
+		{"AtanDeg", Numeric.AtanDeg, "-1", "-45"},
+	}
+
+	fallbacks := map[string]func(x Numeric, bits uint64) Numeric{
+		"SinDeg": sinDeg, "CosDeg": cosDeg, "TanDeg": tanDeg, "AsinDeg": asinDeg, "AcosDeg": acosDeg, "AtanDeg": atanDeg,
+	}
+
+	for _, tt := range tests {
+		if got := tt.f(New(tt.x)).ShortestString(); got != tt.want {
+			t.Errorf("New(%q).%s() = %s, want %s", tt.x, tt.name, got, tt.want)
+		}
+
+		if got := fallbacks[tt.name](New(tt.x), PrecisionBits).ShortestString(); got != tt.want {
+			t.Errorf("%s fallback of %s = %s, want %s", tt.name, tt.x, got, tt.want)
+		}
+	}
+
+	if _, err := New(90).TanDegE(); err == nil {
+		t.Errorf("New(90).TanDegE() returned no error")
+	}
+
+	if _, err := New(-270).TanDegE(); err == nil {
+		t.Errorf("New(-270).TanDegE() returned no error")
+	}
+}
+
+func TestAtan2DegExact(t *testing.T) {
+	tests := []struct {
+		y, x string
+		want string
+	}{
+		{"1", "1", "45"},
+		{"1", "-1", "135"},
+		{"-2", "-2", "-135"},
+		{"0", "-1", "180"},
+		{"0", "1", "0"},
+		{"3", "0", "90"},
+		{"-3", "0", "-90"},
+	}
+
+	for _, tt := range tests {
+		if got := New(tt.y).Atan2Deg(New(tt.x)).ShortestString(); got != tt.want {
+			t.Errorf("New(%q).Atan2Deg(%s) = %s, want %s", tt.y, tt.x, got, tt.want)
+		}
+
+		if got := atan2Deg(New(tt.y), New(tt.x), PrecisionBits).ShortestString(); got != tt.want {
+			t.Errorf("atan2Deg fallback of (%s, %s) = %s, want %s", tt.y, tt.x, got, tt.want)
+		}
+	}
+}
+
+// The fallbacks for MPFR versions before 4.2.0 have to agree with mpfr_sinu and its siblings.
+func TestDegreesFallback(t *testing.T) {
+	angles := []string{
+		"0", "1e-10", "0.1", "1", "15", "29.9", "44.99", "45", "60.5", "89.999", "91", "135", "179", "181",
+		"225", "269.5", "300", "359.5", "360", "721", "-30", "-200", "12345.678", "1e20",
+	}
+	ratios := []string{"-1", "-0.75", "-0.5", "-0.1", "0", "1e-10", "0.3", "0.5", "0.9999", "1"}
+
+	for _, bits := range []uint64{24, 53, 200} {
+		for _, angle := range angles {
+			x := NewWithPrecision(angle, bits)
+
+			if got, want := sinDeg(x, bits), x.SinDeg(); !got.Equal(want) {
+				t.Errorf("sinDeg(%s) at %d bits = %s, want %s", angle, bits, got.ShortestString(), want.ShortestString())
+			}
+
+			if got, want := cosDeg(x, bits), x.CosDeg(); !got.Equal(want) {
+				t.Errorf("cosDeg(%s) at %d bits = %s, want %s", angle, bits, got.ShortestString(), want.ShortestString())
+			}
+
+			if got, want := tanDeg(x, bits), x.TanDeg(); !got.Equal(want) {
+				t.Errorf("tanDeg(%s) at %d bits = %s, want %s", angle, bits, got.ShortestString(), want.ShortestString())
+			}
+
+			if got, want := atanDeg(x, bits), x.AtanDeg(); !got.Equal(want) {
+				t.Errorf("atanDeg(%s) at %d bits = %s, want %s", angle, bits, got.ShortestString(), want.ShortestString())
+			}
+
+			for _, other := range []string{"1", "-3", "0.001"} {
+				y := NewWithPrecision(other, bits)
+				if got, want := atan2Deg(x, y, bits), x.Atan2Deg(y); !got.Equal(want) {
+					t.Errorf("atan2Deg(%s, %s) at %d bits = %s, want %s", angle, other, bits, got.ShortestString(), want.ShortestString())
+				}
+			}
+		}
+
+		for _, ratio := range ratios {
+			x := NewWithPrecision(ratio, bits)
+
+			if got, want := asinDeg(x, bits), x.AsinDeg(); !got.Equal(want) {
+				t.Errorf("asinDeg(%s) at %d bits = %s, want %s", ratio, bits, got.ShortestString(), want.ShortestString())
+			}
+
+			if got, want := acosDeg(x, bits), x.AcosDeg(); !got.Equal(want) {
+				t.Errorf("acosDeg(%s) at %d bits = %s, want %s", ratio, bits, got.ShortestString(), want.ShortestString())
+			}
+		}
+	}
+}
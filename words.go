@@ -0,0 +1,275 @@
+package numeric
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Language used to spell out numbers in words.
+type WordsLanguage int
+
+const (
+	WordsEnglish    WordsLanguage = iota // one thousand two hundred thirty-four
+	WordsIndonesian                      // seribu dua ratus tiga puluh empat (terbilang)
+)
+
+// Determines how the fractional part of a number is spelled out.
+type FractionStyle int
+
+const (
+	FractionDigits  FractionStyle = iota // Digit by digit: "one point five six" or "satu koma lima enam"
+	FractionOver                         // As a fraction of a power of ten, like on cheques: "one and 56/100" or "satu dan 56/100"
+	FractionIgnored                      // Not spelled out at all: "one" or "satu"
+)
+
+// region Global Variables
+
+var englishOnes = []string{
+	"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+	"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen",
+}
+
+var englishTens = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+
+// English short scale names by groups of three digits.
+var englishScales = []string{
+	"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion",
+	"sextillion", "septillion", "octillion", "nonillion", "decillion",
+}
+
+var indonesianOnes = []string{"nol", "satu", "dua", "tiga", "empat", "lima", "enam", "tujuh", "delapan", "sembilan"}
+
+// Indonesian scale names by groups of three digits.
+var indonesianScales = []string{
+	"", "ribu", "juta", "miliar", "triliun", "kuadriliun", "kuintiliun",
+	"sekstiliun", "septiliun", "oktiliun", "noniliun", "desiliun",
+}
+
+// endregion
+
+// region Public
+
+// Returns numeric spelled out in words, with the fractional part spelled digit by digit.
+// Example: 1234.56 is "one thousand two hundred thirty-four point five six" in English,
+// and "seribu dua ratus tiga puluh empat koma lima enam" in Indonesian.
+// The integer part is spelled from the exact value of the number, so it is not limited to the range of int64.
+// Currency names are not added, e.g. append " rupiah" for Indonesian amounts.
+// Panics if the language is unknown or if the number is infinity or NaN.
+func (n Numeric) Words(lang WordsLanguage) string {
+	if !n.init {
+		n = New(0)
+	}
+
+	n.checkWords(lang)
+
+	integer := strings.TrimPrefix(n.str(0, RoundTowardZero), "-")
+
+	_, fraction, _ := strings.Cut(n.ShortestString(), ".")
+
+	return lang.words(n.LessThan(0), integer, fraction, FractionDigits)
+}
+
+// Returns numeric rounded to `dp` decimal places and spelled out in words, with the fractional part spelled in the given style.
+// Example: 1234.56 with 2 decimal places and FractionOver is "one thousand two hundred thirty-four and 56/100" in English.
+// Use 0 decimal places to spell out the rounded integer only.
+// Panics if the language is unknown or if the number is infinity or NaN.
+func (n Numeric) WordsWithFraction(lang WordsLanguage, style FractionStyle, dp uint64) string {
+	if !n.init {
+		n = New(0)
+	}
+
+	n.checkWords(lang)
+
+	str := n.str(dp, RoundNearest)
+
+	negative := strings.HasPrefix(str, "-") && strings.Trim(str, "-0.") != ""
+	integer, fraction, _ := strings.Cut(strings.TrimPrefix(str, "-"), ".")
+
+	return lang.words(negative, integer, fraction, style)
+}
+
+// endregion
+
+// region Private
+
+// Panics if the language is unknown or if the number cannot be spelled out.
+func (n Numeric) checkWords(lang WordsLanguage) {
+	if lang != WordsEnglish && lang != WordsIndonesian {
+		panic(fmt.Sprintf("numeric: Invalid language. Language has to be WordsEnglish or WordsIndonesian. Got: %d", lang))
+	}
+
+	if str, ok := n.nonFinite(); ok {
+		panic(fmt.Sprintf("numeric: Invalid number. %s cannot be spelled out in words", str))
+	}
+}
+
+// Spells out a number given as its sign, integer digits and fractional digits.
+func (l WordsLanguage) words(negative bool, integer string, fraction string, style FractionStyle) string {
+	words := []string{}
+	if negative {
+		words = append(words, "minus")
+	}
+
+	integer = strings.TrimLeft(integer, "0")
+	if integer == "" {
+		words = append(words, l.ones()[0])
+	} else {
+		words = append(words, l.integerWords(integer)...)
+	}
+
+	switch style {
+	case FractionDigits:
+		if fraction = strings.TrimRight(fraction, "0"); fraction == "" {
+			break
+		}
+
+		words = append(words, l.word("point", "koma"))
+		for _, digit := range fraction {
+			words = append(words, l.ones()[digit-'0'])
+		}
+	case FractionOver:
+		if fraction == "" {
+			break
+		}
+
+		words = append(words, l.word("and", "dan"), fraction+"/1"+strings.Repeat("0", len(fraction)))
+	}
+
+	return strings.Join(words, " ")
+}
+
+// Spells out a string of integer digits without leading zeros.
+// Numbers beyond the largest scale name are spelled as a multiple of it, e.g. "one thousand decillion".
+func (l WordsLanguage) integerWords(digits string) []string {
+	maxScale := len(l.scales()) - 1
+	if len(digits) > 3*(maxScale+1) {
+		split := len(digits) - 3*maxScale
+
+		words := append(l.integerWords(digits[:split]), l.scales()[maxScale])
+		if low := strings.TrimLeft(digits[split:], "0"); low != "" {
+			words = append(words, l.integerWords(low)...)
+		}
+
+		return words
+	}
+
+	words := []string{}
+	for scale := (len(digits) - 1) / 3; scale >= 0; scale-- {
+		end := len(digits) - 3*scale
+		value, _ := strconv.Atoi(digits[max(end-3, 0):end])
+
+		if value == 0 {
+			continue
+		}
+
+		words = append(words, l.groupWords(value, scale)...)
+	}
+
+	return words
+}
+
+// Spells out a group of three digits followed by its scale name.
+func (l WordsLanguage) groupWords(value int, scale int) []string {
+	if l == WordsIndonesian {
+		// One thousand is "seribu" rather than "satu ribu", but one million is "satu juta"
+		if value == 1 && scale == 1 {
+			return []string{"seribu"}
+		}
+
+		words := indonesianHundreds(value)
+		if scale > 0 {
+			words = append(words, indonesianScales[scale])
+		}
+
+		return words
+	}
+
+	words := englishHundreds(value)
+	if scale > 0 {
+		words = append(words, englishScales[scale])
+	}
+
+	return words
+}
+
+// Spells out a number from 1 to 999 in English, e.g. "two hundred thirty-four".
+func englishHundreds(value int) []string {
+	words := []string{}
+
+	if value >= 100 {
+		words = append(words, englishOnes[value/100], "hundred")
+		value %= 100
+	}
+
+	switch {
+	case value == 0:
+	case value < 20:
+		words = append(words, englishOnes[value])
+	case value%10 == 0:
+		words = append(words, englishTens[value/10])
+	default:
+		words = append(words, englishTens[value/10]+"-"+englishOnes[value%10])
+	}
+
+	return words
+}
+
+// Spells out a number from 1 to 999 in Indonesian, e.g. "dua ratus tiga puluh empat".
+func indonesianHundreds(value int) []string {
+	words := []string{}
+
+	switch {
+	case value >= 200:
+		words = append(words, indonesianOnes[value/100], "ratus")
+	case value >= 100:
+		words = append(words, "seratus")
+	}
+	value %= 100
+
+	switch {
+	case value == 0:
+	case value == 10:
+		words = append(words, "sepuluh")
+	case value == 11:
+		words = append(words, "sebelas")
+	case value < 10:
+		words = append(words, indonesianOnes[value])
+	case value < 20:
+		words = append(words, indonesianOnes[value%10], "belas")
+	default:
+		words = append(words, indonesianOnes[value/10], "puluh")
+		if value%10 != 0 {
+			words = append(words, indonesianOnes[value%10])
+		}
+	}
+
+	return words
+}
+
+func (l WordsLanguage) ones() []string {
+	if l == WordsIndonesian {
+		return indonesianOnes
+	}
+
+	return englishOnes
+}
+
+func (l WordsLanguage) scales() []string {
+	if l == WordsIndonesian {
+		return indonesianScales
+	}
+
+	return englishScales
+}
+
+// Returns the English or the Indonesian word.
+func (l WordsLanguage) word(english string, indonesian string) string {
+	if l == WordsIndonesian {
+		return indonesian
+	}
+
+	return english
+}
+
+// endregion
@@ -0,0 +1,56 @@
+package numeric
+
+import "testing"
+
+func TestWords(t *testing.T) {
+	tests := []struct {
+		value      string
+		english    string
+		indonesian string
+	}{
+		{"0", "zero", "nol"},
+		{"-0.5", "minus zero point five", "minus nol koma lima"},
+		{"115", "one hundred fifteen", "seratus lima belas"},
+		{"-21", "minus twenty-one", "minus dua puluh satu"},
+		{"1000000", "one million", "satu juta"},
+		{"1234.56", "one thousand two hundred thirty-four point five six", "seribu dua ratus tiga puluh empat koma lima enam"},
+	}
+
+	for _, tt := range tests {
+		if got := New(tt.value).Words(WordsEnglish); got != tt.english {
+			t.Errorf("New(%q).Words(WordsEnglish) = %s, want %s", tt.value, got, tt.english)
+		}
+
+		if got := New(tt.value).Words(WordsIndonesian); got != tt.indonesian {
+			t.Errorf("New(%q).Words(WordsIndonesian) = %s, want %s", tt.value, got, tt.indonesian)
+		}
+	}
+}
+
+func TestWordsPanics(t *testing.T) {
+	big := New("1e200000000")
+	inf := big.Multiply(big)
+
+	tests := []struct {
+		name string
+		f    func()
+	}{
+		{"inf.Words", func() { inf.Words(WordsEnglish) }},
+		{"-inf.Words", func() { inf.Neg().Words(WordsIndonesian) }},
+		{"inf.WordsWithFraction", func() { inf.WordsWithFraction(WordsEnglish, FractionOver, 2) }},
+		{"Words(WordsLanguage(5))", func() { New(1).Words(WordsLanguage(5)) }},
+		{"WordsWithFraction(WordsLanguage(5))", func() { New(1).WordsWithFraction(WordsLanguage(5), FractionDigits, 2) }},
+	}
+
+	for _, tt := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s did not panic", tt.name)
+				}
+			}()
+
+			tt.f()
+		}()
+	}
+}